@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// abilityHolder is a caught Pokemon that has an ability.
+type abilityHolder struct {
+	name   string // name of the Pokemon
+	hidden bool   // hidden is set when it is the Pokemon's hidden ability
+}
+
+// commandAbility describes an ability and lists the caught Pokemon that have it.
+//
+// Usage: ability <ability_name>
+func commandAbility(ctx context.Context, cfg *config, args ...string) error {
+	if len(args) != 1 {
+		return errors.New("you must provide an ability name")
+	}
+
+	name := args[0]
+	ability, err := cfg.pokeapiClient.GetAbilityContext(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no ability named %s", name)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(ability.Name)
+	if effect := localized(ability.ShortEffect, cfg.language); effect != "" {
+		fmt.Printf("  %s\n", effect)
+	}
+	fmt.Printf("%d pokemon can have it\n", len(ability.Pokemon))
+
+	holders := caughtWithAbility(cfg.caughtPokemon, ability.Name)
+	if len(holders) == 0 {
+		fmt.Println("None of your pokemon have it")
+		return nil
+	}
+	fmt.Println("Your pokemon with it:")
+	for _, holder := range holders {
+		if holder.hidden {
+			fmt.Printf("  - %s (hidden)\n", holder.name)
+			continue
+		}
+		fmt.Printf("  - %s\n", holder.name)
+	}
+	return nil
+}
+
+// caughtWithAbility returns the caught Pokemon that have the ability, sorted
+// by name. The caught Pokemon's own data says whether the ability is hidden.
+func caughtWithAbility(caught map[string]pokeapi.Pokemon, abilityName string) []abilityHolder {
+	holders := []abilityHolder{}
+	for _, pokemon := range caught {
+		for _, ability := range pokemon.Abilities {
+			if ability.Ability.Name == abilityName {
+				holders = append(holders, abilityHolder{name: pokemon.Name, hidden: ability.IsHidden})
+				break
+			}
+		}
+	}
+	sort.Slice(holders, func(i, j int) bool {
+		return holders[i].name < holders[j].name
+	})
+	return holders
+}
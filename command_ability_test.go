@@ -0,0 +1,45 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// TestCaughtWithAbility verifies which caught Pokemon are listed for an ability.
+func TestCaughtWithAbility(t *testing.T) {
+	dat := []byte(`{
+		"gengar": {"name": "gengar", "abilities": [{"ability": {"name": "cursed-body"}, "is_hidden": false}]},
+		"bronzong": {"name": "bronzong", "abilities": [
+			{"ability": {"name": "levitate"}, "is_hidden": false},
+			{"ability": {"name": "heavy-metal"}, "is_hidden": true}
+		]},
+		"koffing": {"name": "koffing", "abilities": [
+			{"ability": {"name": "neutralizing-gas"}, "is_hidden": false},
+			{"ability": {"name": "levitate"}, "is_hidden": false},
+			{"ability": {"name": "stench"}, "is_hidden": true}
+		]},
+		"aron": {"name": "aron", "abilities": [{"ability": {"name": "heavy-metal"}, "is_hidden": true}]}
+	}`)
+	caught := map[string]pokeapi.Pokemon{}
+	if err := json.Unmarshal(dat, &caught); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		ability  string
+		expected []abilityHolder
+	}{
+		{ability: "levitate", expected: []abilityHolder{{name: "bronzong"}, {name: "koffing"}}},
+		{ability: "heavy-metal", expected: []abilityHolder{{name: "aron", hidden: true}, {name: "bronzong", hidden: true}}},
+		{ability: "overgrow", expected: []abilityHolder{}},
+	}
+
+	for _, c := range cases {
+		if actual := caughtWithAbility(caught, c.ability); !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("caughtWithAbility(%s) == %v, expected %v", c.ability, actual, c.expected)
+		}
+	}
+}
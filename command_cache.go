@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/masteidel/pokedexcli/internal/pokecache"
+)
+
+// managedCache is implemented by the pokecache backends that can report
+// statistics and be inspected from the REPL.
+type managedCache interface {
+	Stats() pokecache.Stats
+	Keys() []string
+	Clear()
+	Delete(key string)
+}
+
+// commandCache inspects and manages the PokeAPI response cache.
+//
+// Usage: cache stats|list|clear, or cache evict <key>
+func commandCache(ctx context.Context, cfg *config, args ...string) error {
+	cache, ok := cfg.pokeapiClient.Cache().(managedCache)
+	if !ok {
+		return errors.New("the cache in use can't be inspected")
+	}
+	if len(args) == 0 {
+		return errors.New("usage: cache stats|list|clear|evict <key>")
+	}
+
+	switch args[0] {
+	case "stats":
+		stats := cache.Stats()
+		lookups := stats.Hits + stats.Misses
+		hitRate := 0.0
+		if lookups > 0 {
+			hitRate = float64(stats.Hits) / float64(lookups) * 100
+		}
+		fmt.Printf("Entries: %d (%s)\n", stats.Entries, formatBytes(stats.Bytes))
+		fmt.Printf("Hits: %d, Misses: %d (%.1f%% hit rate)\n", stats.Hits, stats.Misses, hitRate)
+		fmt.Printf("Stale hits: %d\n", stats.StaleHits)
+		fmt.Printf("Evictions: %d, Expirations: %d\n", stats.Evictions, stats.Expirations)
+	case "list":
+		keys := cache.Keys()
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Printf(" - %s\n", key)
+		}
+		fmt.Printf("%d cached responses\n", len(keys))
+	case "clear":
+		cache.Clear()
+		fmt.Println("Cache cleared")
+	case "evict":
+		if len(args) != 2 {
+			return errors.New("you must provide a cache key, see: cache list")
+		}
+		cache.Delete(args[1])
+		fmt.Printf("Evicted %s\n", args[1])
+	default:
+		return fmt.Errorf("unknown cache command: %s", args[0])
+	}
+	return nil
+}
+
+// formatBytes renders a byte count with a binary unit suffix.
+func formatBytes(n int) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := unit, 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
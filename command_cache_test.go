@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+	"github.com/masteidel/pokedexcli/internal/pokecache"
+)
+
+// TestFormatBytes verifies the units of the cache stats sizes.
+func TestFormatBytes(t *testing.T) {
+	cases := []struct {
+		input    int
+		expected string
+	}{
+		{input: 0, expected: "0 B"},
+		{input: 1023, expected: "1023 B"},
+		{input: 1024, expected: "1.0 KiB"},
+		{input: 1536, expected: "1.5 KiB"},
+		{input: 5 * 1024 * 1024, expected: "5.0 MiB"},
+		{input: 3 * 1024 * 1024 * 1024, expected: "3.0 GiB"},
+	}
+
+	for _, c := range cases {
+		if actual := formatBytes(c.input); actual != c.expected {
+			t.Errorf("formatBytes(%d) == %q, expected %q", c.input, actual, c.expected)
+		}
+	}
+}
+
+// TestCommandCache verifies that the cache subcommands reach the cache.
+func TestCommandCache(t *testing.T) {
+	cases := []struct {
+		args      []string
+		remaining []string
+		hasError  bool
+	}{
+		{args: []string{"stats"}, remaining: []string{"a", "b"}},
+		{args: []string{"list"}, remaining: []string{"a", "b"}},
+		{args: []string{"evict", "a"}, remaining: []string{"b"}},
+		{args: []string{"evict", "missing"}, remaining: []string{"a", "b"}},
+		{args: []string{"clear"}, remaining: []string{}},
+		{args: []string{"evict"}, remaining: []string{"a", "b"}, hasError: true},
+		{args: []string{"flush"}, remaining: []string{"a", "b"}, hasError: true},
+		{args: []string{}, remaining: []string{"a", "b"}, hasError: true},
+	}
+
+	for _, c := range cases {
+		cache := pokecache.NewCache(time.Hour)
+		cache.Add("a", []byte("1"))
+		cache.Add("b", []byte("2"))
+		cfg := &config{pokeapiClient: pokeapi.NewClient(pokeapi.WithCache(cache))}
+
+		err := commandCache(context.Background(), cfg, c.args...)
+		if c.hasError && err == nil {
+			t.Errorf("cache %v: expected an error", c.args)
+		}
+		if !c.hasError && err != nil {
+			t.Errorf("cache %v: unexpected error: %v", c.args, err)
+		}
+		if cache.Len() != len(c.remaining) {
+			t.Errorf("cache %v: expected %v to remain, got %v", c.args, c.remaining, cache.Keys())
+		}
+		for _, key := range c.remaining {
+			if _, ok := cache.Get(key); !ok {
+				t.Errorf("cache %v: expected %s to remain", c.args, key)
+			}
+		}
+		cfg.pokeapiClient.Close()
+	}
+}
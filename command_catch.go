@@ -1,18 +1,24 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
 )
 
-func commandCatch(cfg *config, args ...string) error {
+func commandCatch(ctx context.Context, cfg *config, args ...string) error {
 	if len(args) != 1 {
 		return errors.New("you must provide a pokemon name")
 	}
 
 	name := args[0]
-	pokemon, err := cfg.pokeapiClient.GetPokemon(name)
+	pokemon, err := cfg.pokeapiClient.GetPokemonContext(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no Pokemon named %s", name)
+	}
 	if err != nil {
 		return err
 	}
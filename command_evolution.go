@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// commandEvolution prints the evolution family of a Pokemon as a tree, with
+// what it takes to evolve at every branch.
+//
+// Usage: evolution <pokemon_name>
+func commandEvolution(ctx context.Context, cfg *config, args ...string) error {
+	if len(args) != 1 {
+		return errors.New("you must provide a pokemon name")
+	}
+
+	name := args[0]
+	species, err := getSpecies(ctx, cfg, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no Pokemon named %s", name)
+	}
+	if err != nil {
+		return err
+	}
+
+	chainID, ok := species.EvolutionChain.ID()
+	if !ok {
+		return fmt.Errorf("%s has no evolution chain", species.Name)
+	}
+	chain, err := cfg.pokeapiClient.GetEvolutionChainContext(ctx, chainID)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range evolutionTree(chain.Chain) {
+		fmt.Println(line)
+	}
+	return nil
+}
+
+// evolutionTree renders the chain starting at link as lines of a tree,
+// e.g. for Eevee:
+//
+//	eevee
+//	├── vaporeon (use water-stone)
+//	├── jolteon (use thunder-stone)
+//	...
+func evolutionTree(link pokeapi.ChainLink) []string {
+	lines := []string{chainLinkName(link)}
+	return appendEvolutions(lines, link, "")
+}
+
+// appendEvolutions adds the species link evolves into to lines, indented by prefix.
+func appendEvolutions(lines []string, link pokeapi.ChainLink, prefix string) []string {
+	for i, next := range link.EvolvesTo {
+		branch, indent := "├── ", "│   "
+		if i == len(link.EvolvesTo)-1 {
+			branch, indent = "└── ", "    "
+		}
+		line := prefix + branch + chainLinkName(next)
+		if how := describeEvolution(next.EvolutionDetails); how != "" {
+			line += " (" + how + ")"
+		}
+		lines = append(lines, line)
+		lines = appendEvolutions(lines, next, prefix+indent)
+	}
+	return lines
+}
+
+// chainLinkName returns the species name of link, marking baby forms.
+func chainLinkName(link pokeapi.ChainLink) string {
+	if link.IsBaby {
+		return link.Species.Name + " [baby]"
+	}
+	return link.Species.Name
+}
+
+// describeEvolution summarizes the ways of evolving given by details. Games
+// that evolve the species differently each add an alternative.
+func describeEvolution(details []pokeapi.EvolutionDetail) string {
+	var ways []string
+	seen := map[string]bool{}
+	for _, detail := range details {
+		way := strings.Join(evolutionConditions(detail), ", ")
+		if way == "" || seen[way] {
+			continue
+		}
+		seen[way] = true
+		ways = append(ways, way)
+	}
+	return strings.Join(ways, " or ")
+}
+
+// evolutionConditions lists what a single evolution detail requires, the
+// trigger first.
+func evolutionConditions(detail pokeapi.EvolutionDetail) []string {
+	var conditions []string
+	heldItemShown := false
+
+	switch detail.Trigger.Name {
+	case "level-up":
+		if detail.MinLevel != nil {
+			conditions = append(conditions, fmt.Sprintf("level %d", *detail.MinLevel))
+		} else {
+			conditions = append(conditions, "level up")
+		}
+	case "trade":
+		trade := "trade"
+		if detail.HeldItem != nil {
+			trade += " holding " + detail.HeldItem.Name
+			heldItemShown = true
+		}
+		if detail.TradeSpecies != nil {
+			trade += " for " + detail.TradeSpecies.Name
+		}
+		conditions = append(conditions, trade)
+	case "use-item":
+		if detail.Item != nil {
+			conditions = append(conditions, "use "+detail.Item.Name)
+		}
+	default:
+		if detail.Trigger.Name != "" {
+			conditions = append(conditions, strings.ReplaceAll(detail.Trigger.Name, "-", " "))
+		}
+	}
+
+	if detail.HeldItem != nil && !heldItemShown {
+		conditions = append(conditions, "holding "+detail.HeldItem.Name)
+	}
+	if detail.MinHappiness != nil {
+		conditions = append(conditions, fmt.Sprintf("happiness %d+", *detail.MinHappiness))
+	}
+	if detail.MinAffection != nil {
+		conditions = append(conditions, fmt.Sprintf("affection %d+", *detail.MinAffection))
+	}
+	if detail.MinBeauty != nil {
+		conditions = append(conditions, fmt.Sprintf("beauty %d+", *detail.MinBeauty))
+	}
+	switch detail.TimeOfDay {
+	case "day":
+		conditions = append(conditions, "during the day")
+	case "night":
+		conditions = append(conditions, "at night")
+	case "":
+	default:
+		conditions = append(conditions, "at "+detail.TimeOfDay)
+	}
+	if detail.KnownMove != nil {
+		conditions = append(conditions, "knowing "+detail.KnownMove.Name)
+	}
+	if detail.KnownMoveType != nil {
+		conditions = append(conditions, "knowing a "+detail.KnownMoveType.Name+" move")
+	}
+	if detail.Location != nil {
+		conditions = append(conditions, "at "+detail.Location.Name)
+	}
+	if detail.Gender != nil {
+		switch *detail.Gender {
+		case 1:
+			conditions = append(conditions, "female")
+		case 2:
+			conditions = append(conditions, "male")
+		}
+	}
+	if detail.PartySpecies != nil {
+		conditions = append(conditions, "with "+detail.PartySpecies.Name+" in the party")
+	}
+	if detail.PartyType != nil {
+		conditions = append(conditions, "with a "+detail.PartyType.Name+" type in the party")
+	}
+	if detail.RelativePhysicalStats != nil {
+		switch *detail.RelativePhysicalStats {
+		case 1:
+			conditions = append(conditions, "attack > defense")
+		case -1:
+			conditions = append(conditions, "attack < defense")
+		case 0:
+			conditions = append(conditions, "attack = defense")
+		}
+	}
+	if detail.NeedsOverworldRain {
+		conditions = append(conditions, "in the rain")
+	}
+	if detail.TurnUpsideDown {
+		conditions = append(conditions, "upside down")
+	}
+	return conditions
+}
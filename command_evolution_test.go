@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// TestEvolutionTree verifies that branching evolution chains are rendered as a tree.
+func TestEvolutionTree(t *testing.T) {
+	dat := []byte(`{
+		"id": 67,
+		"chain": {
+			"species": {"name": "eevee"},
+			"evolution_details": [],
+			"evolves_to": [
+				{
+					"species": {"name": "vaporeon"},
+					"evolution_details": [{"trigger": {"name": "use-item"}, "item": {"name": "water-stone"}}],
+					"evolves_to": []
+				},
+				{
+					"species": {"name": "espeon"},
+					"evolution_details": [
+						{"trigger": {"name": "level-up"}, "min_happiness": 160, "time_of_day": "day"},
+						{"trigger": {"name": "level-up"}, "min_happiness": 160, "time_of_day": "day"}
+					],
+					"evolves_to": []
+				},
+				{
+					"species": {"name": "sylveon"},
+					"evolution_details": [
+						{"trigger": {"name": "level-up"}, "known_move_type": {"name": "fairy"}, "min_affection": 2},
+						{"trigger": {"name": "use-item"}, "item": {"name": "shiny-stone"}}
+					],
+					"evolves_to": []
+				}
+			]
+		}
+	}`)
+	var chain pokeapi.EvolutionChain
+	if err := json.Unmarshal(dat, &chain); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"eevee",
+		"├── vaporeon (use water-stone)",
+		"├── espeon (level up, happiness 160+, during the day)",
+		"└── sylveon (level up, affection 2+, knowing a fairy move or use shiny-stone)",
+	}
+	actual := evolutionTree(chain.Chain)
+	if strings.Join(actual, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected tree:\n%s\nexpected:\n%s", strings.Join(actual, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+// TestEvolutionConditions verifies how single evolution details are described.
+func TestEvolutionConditions(t *testing.T) {
+	level := 16
+	cases := []struct {
+		detail   pokeapi.EvolutionDetail
+		expected string
+	}{
+		{
+			detail:   pokeapi.EvolutionDetail{Trigger: pokeapi.NamedAPIResource{Name: "level-up"}, MinLevel: &level},
+			expected: "level 16",
+		},
+		{
+			detail: pokeapi.EvolutionDetail{
+				Trigger:  pokeapi.NamedAPIResource{Name: "trade"},
+				HeldItem: &pokeapi.NamedAPIResource{Name: "metal-coat"},
+			},
+			expected: "trade holding metal-coat",
+		},
+		{
+			detail: pokeapi.EvolutionDetail{
+				Trigger:   pokeapi.NamedAPIResource{Name: "level-up"},
+				HeldItem:  &pokeapi.NamedAPIResource{Name: "razor-fang"},
+				TimeOfDay: "night",
+			},
+			expected: "level up, holding razor-fang, at night",
+		},
+		{
+			detail:   pokeapi.EvolutionDetail{Trigger: pokeapi.NamedAPIResource{Name: "three-critical-hits"}},
+			expected: "three critical hits",
+		},
+	}
+
+	for _, c := range cases {
+		if actual := strings.Join(evolutionConditions(c.detail), ", "); actual != c.expected {
+			t.Errorf("expected %q, got %q", c.expected, actual)
+		}
+	}
+}
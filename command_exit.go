@@ -1,12 +1,28 @@
 package main
 
-import "os"
+import (
+	"context"
+	"fmt"
+	"os"
+)
 
 // The function commandExit is used to cleanly terminate the program.
 // It receives the program's configuration as an argument.
+// Before exiting, the session is saved so it can be restored on the next start
+// and the PokeAPI client is closed.
 // Although the function has an error return value, it actually always returns nil
 // because the program exits before the return statement when the exit command is received.
-func commandExit(config *config, args ...string) error {
+func commandExit(ctx context.Context, config *config, args ...string) error {
+	// Autosave the session; a failed save is reported but doesn't prevent exiting
+	if err := saveSession(config); err != nil {
+		fmt.Println("autosave failed:", err)
+	}
+
+	// Stop the client's background work before leaving
+	if err := config.pokeapiClient.Close(); err != nil {
+		fmt.Println("closing the pokeapi client:", err)
+	}
+
 	// os.Exit(0) is used to end the program
 	// The argument 0 is a code that signifies the program has ended successfully
 	os.Exit(0)
@@ -1,17 +1,23 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
 )
 
-func commandExplore(cfg *config, args ...string) error {
+func commandExplore(ctx context.Context, cfg *config, args ...string) error {
 	if len(args) != 1 {
 		return errors.New("you must provide a location name")
 	}
 
 	name := args[0]
-	location, err := cfg.pokeapiClient.GetLocation(name)
+	location, err := cfg.pokeapiClient.GetLocationContext(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no location named %s", name)
+	}
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,9 @@
 package main
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // The function 'commandHelp' is responsible for printing out
 // the names and descriptions of all the possible commands
@@ -11,7 +14,7 @@ import "fmt"
 //
 // It returns an error, allowing the caller to handle situations
 // where the commands cannot be correctly printed to the console.
-func commandHelp(config *config, args ...string) error {
+func commandHelp(ctx context.Context, config *config, args ...string) error {
 	// Blank print to add a new line for neatness
 	fmt.Println()
 
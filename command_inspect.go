@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// commandInspect prints the details of a Pokemon the user has already caught.
+// Only caught Pokemon can be inspected, so no API request is made here.
+func commandInspect(ctx context.Context, cfg *config, args ...string) error {
+	if len(args) != 1 {
+		return errors.New("you must provide a pokemon name")
+	}
+
+	name := args[0]
+	pokemon, ok := cfg.caughtPokemon[name]
+	if !ok {
+		return fmt.Errorf("you have not caught %s yet", name)
+	}
+
+	printPokemon(os.Stdout, pokemon)
+	return nil
+}
+
+// printPokemon writes the details of pokemon to w, as shown by inspect.
+func printPokemon(w io.Writer, pokemon pokeapi.Pokemon) {
+	fmt.Fprintf(w, "Name: %s\n", pokemon.Name)
+	fmt.Fprintf(w, "Height: %d\n", pokemon.Height)
+	fmt.Fprintf(w, "Weight: %d\n", pokemon.Weight)
+
+	fmt.Fprintln(w, "Stats:")
+	for _, stat := range pokemon.Stats {
+		fmt.Fprintf(w, "  - %s: %d (effort %d)\n", stat.Stat.Name, stat.BaseStat, stat.Effort)
+	}
+
+	fmt.Fprintln(w, "Types:")
+	for _, typeInfo := range pokemon.Types {
+		fmt.Fprintf(w, "  - %s\n", typeInfo.Type.Name)
+	}
+
+	fmt.Fprintln(w, "Abilities:")
+	for _, ability := range pokemon.Abilities {
+		if ability.IsHidden {
+			fmt.Fprintf(w, "  - %s (hidden)\n", ability.Ability.Name)
+			continue
+		}
+		fmt.Fprintf(w, "  - %s\n", ability.Ability.Name)
+	}
+
+	fmt.Fprintf(w, "Moves: %d learnable\n", len(pokemon.Moves))
+	for _, method := range summarizeMoveLearnMethods(pokemon) {
+		fmt.Fprintf(w, "  - %s: %d\n", method.name, method.count)
+	}
+}
+
+// moveLearnMethodCount holds how many moves a Pokemon can learn by a given method.
+type moveLearnMethodCount struct {
+	name  string
+	count int
+}
+
+// summarizeMoveLearnMethods counts the moves of a Pokemon by the way they are
+// learned (level-up, machine, egg, ...). A move is counted once per method,
+// no matter how many version groups list it. The result is sorted by count.
+func summarizeMoveLearnMethods(pokemon pokeapi.Pokemon) []moveLearnMethodCount {
+	counts := map[string]int{}
+	for _, move := range pokemon.Moves {
+		seen := map[string]bool{}
+		for _, detail := range move.VersionGroupDetails {
+			method := detail.MoveLearnMethod.Name
+			if seen[method] {
+				continue
+			}
+			seen[method] = true
+			counts[method]++
+		}
+	}
+
+	summary := make([]moveLearnMethodCount, 0, len(counts))
+	for name, count := range counts {
+		summary = append(summary, moveLearnMethodCount{name: name, count: count})
+	}
+	sort.Slice(summary, func(i, j int) bool {
+		if summary[i].count != summary[j].count {
+			return summary[i].count > summary[j].count
+		}
+		return summary[i].name < summary[j].name
+	})
+	return summary
+}
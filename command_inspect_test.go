@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// TestPrintPokemon verifies the details shown by the inspect command.
+func TestPrintPokemon(t *testing.T) {
+	cases := []struct {
+		name     string
+		pokemon  string
+		expected string
+	}{
+		{
+			name: "hidden ability",
+			pokemon: `{"name": "pikachu", "height": 4, "weight": 60,
+				"stats": [{"base_stat": 35, "effort": 0, "stat": {"name": "hp"}}],
+				"types": [{"type": {"name": "electric"}}],
+				"abilities": [{"ability": {"name": "static"}, "is_hidden": false}, {"ability": {"name": "lightning-rod"}, "is_hidden": true}]}`,
+			expected: "Name: pikachu\nHeight: 4\nWeight: 60\n" +
+				"Stats:\n  - hp: 35 (effort 0)\n" +
+				"Types:\n  - electric\n" +
+				"Abilities:\n  - static\n  - lightning-rod (hidden)\n" +
+				"Moves: 0 learnable\n",
+		},
+		{
+			name: "move summary",
+			pokemon: `{"name": "mew", "moves": [
+				{"move": {"name": "pound"}, "version_group_details": [{"move_learn_method": {"name": "level-up"}}, {"move_learn_method": {"name": "level-up"}}]},
+				{"move": {"name": "psychic"}, "version_group_details": [{"move_learn_method": {"name": "machine"}}, {"move_learn_method": {"name": "level-up"}}]},
+				{"move": {"name": "surf"}, "version_group_details": [{"move_learn_method": {"name": "machine"}}]},
+				{"move": {"name": "transform"}, "version_group_details": [{"move_learn_method": {"name": "tutor"}}]}
+			]}`,
+			expected: "Name: mew\nHeight: 0\nWeight: 0\nStats:\nTypes:\nAbilities:\n" +
+				"Moves: 4 learnable\n  - level-up: 2\n  - machine: 2\n  - tutor: 1\n",
+		},
+	}
+
+	for _, c := range cases {
+		var pokemon pokeapi.Pokemon
+		if err := json.Unmarshal([]byte(c.pokemon), &pokemon); err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		var out bytes.Buffer
+		printPokemon(&out, pokemon)
+		if out.String() != c.expected {
+			t.Errorf("%s: printPokemon wrote\n%s\nexpected\n%s", c.name, out.String(), c.expected)
+		}
+	}
+}
+
+// TestSummarizeMoveLearnMethods verifies that moves are counted once per method.
+func TestSummarizeMoveLearnMethods(t *testing.T) {
+	var pokemon pokeapi.Pokemon
+	dat := []byte(`{"moves": [
+		{"move": {"name": "tackle"}, "version_group_details": [{"move_learn_method": {"name": "level-up"}}, {"move_learn_method": {"name": "level-up"}}, {"move_learn_method": {"name": "egg"}}]},
+		{"move": {"name": "growl"}, "version_group_details": [{"move_learn_method": {"name": "level-up"}}]}
+	]}`)
+	if err := json.Unmarshal(dat, &pokemon); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []moveLearnMethodCount{{name: "level-up", count: 2}, {name: "egg", count: 1}}
+	if actual := summarizeMoveLearnMethods(pokemon); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("summarizeMoveLearnMethods == %v, expected %v", actual, expected)
+	}
+}
+
+// TestInspectUncaught verifies that only caught Pokemon can be inspected.
+func TestInspectUncaught(t *testing.T) {
+	cfg := &config{caughtPokemon: map[string]pokeapi.Pokemon{}}
+	err := commandInspect(context.Background(), cfg, "pikachu")
+	if err == nil || err.Error() != "you have not caught pikachu yet" {
+		t.Errorf("expected a not caught error, got %v", err)
+	}
+	if err := commandInspect(context.Background(), cfg); err == nil {
+		t.Error("expected an error without a pokemon name")
+	}
+}
@@ -1,55 +1,81 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strconv"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
 )
 
 // commandMapf retrieves a list of `locations` from the next page of
 // the pokeapi and outputs these locations to the console.
-func commandMapf(cfg *config, args ...string) error {
-	// Outgoing API request to the pokeapi client to receive a list of locations
-	locationsResp, err := cfg.pokeapiClient.ListLocations(cfg.nextLocationsURL)
+// With a page number, that page is shown instead.
+//
+// Usage: map [page]
+func commandMapf(ctx context.Context, cfg *config, args ...string) error {
+	if len(args) > 1 {
+		return errors.New("usage: map [page]")
+	}
 
-	// Error handling for the API request
-	if err != nil {
-		return err
+	// A page number jumps straight to that page
+	if len(args) == 1 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid page number: %s", args[0])
+		}
+		page, err := cfg.locations.Page(ctx, n)
+		if errors.Is(err, pokeapi.ErrNoPage) {
+			return fmt.Errorf("there are only %d pages", cfg.locations.Pages())
+		}
+		if err != nil {
+			return err
+		}
+		printLocations(cfg, page)
+		return nil
+	}
+
+	// If there is no next page, the user has seen every location
+	if !cfg.locations.HasNext() {
+		return errors.New("you're on the last page")
 	}
 
-	// Update the 'nextLocationsURL' and 'prevLocationsURL' fields in the config with the received response
-	cfg.nextLocationsURL = locationsResp.Next
-	cfg.prevLocationsURL = locationsResp.Previous
+	// Outgoing API request to the pokeapi client to receive the next page of locations
+	page, err := cfg.locations.Next(ctx)
 
-	// Iterate over each location in the response and display its name
-	for _, loc := range locationsResp.Results {
-		fmt.Println(loc.Name)
+	// Error handling for the API request
+	if err != nil {
+		return err
 	}
+	printLocations(cfg, page)
 	return nil
 }
 
 // commandMapb retrieves a list of `locations` from the previous page
 // of the pokeapi and outputs these locations to the console.
-func commandMapb(cfg *config, args ...string) error {
-	// If 'prevLocationsURL' is nil, it implies there are no more previous pages, and hence an error is returned
-	if cfg.prevLocationsURL == nil {
+func commandMapb(ctx context.Context, cfg *config, args ...string) error {
+	// If there is no previous page, the user is on the first page, and hence an error is returned
+	if !cfg.locations.HasPrev() {
 		return errors.New("you're on the first page")
 	}
 
-	// Outgoing API request to the pokeapi client to receive a list of locations from the previous page
-	locationResp, err := cfg.pokeapiClient.ListLocations(cfg.prevLocationsURL)
+	// Outgoing API request to the pokeapi client to receive the previous page of locations
+	page, err := cfg.locations.Prev(ctx)
 
 	// Error handling for the API request
 	if err != nil {
 		return err
 	}
+	printLocations(cfg, page)
+	return nil
+}
 
-	// Update the 'nextLocationsURL' and 'prevLocationsURL' fields in the config with the received response
-	cfg.nextLocationsURL = locationResp.Next
-	cfg.prevLocationsURL = locationResp.Previous
-
-	// Iterate over each location in the response and display its name
-	for _, loc := range locationResp.Results {
+// printLocations displays the name of each location on page, followed by
+// the position of the page in the list.
+func printLocations(cfg *config, page pokeapi.RespShallowLocations) {
+	for _, loc := range page.Results {
 		fmt.Println(loc.Name)
 	}
-	return nil
+	fmt.Printf("Page %d/%d\n", cfg.locations.Current(), cfg.locations.Pages())
 }
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// damageClasses are the valid values of the moves --class filter.
+var damageClasses = map[string]bool{"physical": true, "special": true, "status": true}
+
+// commandMove prints the battle data of a move.
+//
+// Usage: move <move_name>
+func commandMove(ctx context.Context, cfg *config, args ...string) error {
+	if len(args) != 1 {
+		return errors.New("you must provide a move name")
+	}
+
+	name := args[0]
+	move, err := cfg.pokeapiClient.GetMoveContext(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no move named %s", name)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s (%s, %s)\n", move.Name, move.Type.Name, move.DamageClass.Name)
+	fmt.Printf("Power: %s\n", optionalValue(move.Power, ""))
+	fmt.Printf("Accuracy: %s\n", optionalValue(move.Accuracy, "%"))
+	fmt.Printf("PP: %s\n", optionalValue(move.PP, ""))
+	fmt.Printf("Priority: %+d\n", move.Priority)
+	if effect := localized(move.ShortEffect, cfg.language); effect != "" {
+		fmt.Printf("Effect: %s\n", effect)
+	}
+	if len(move.StatChanges) > 0 {
+		fmt.Println("Stat changes:")
+		for _, change := range move.StatChanges {
+			fmt.Printf("  - %s %+d\n", change.Stat.Name, change.Change)
+		}
+	}
+	return nil
+}
+
+// moveFilter selects the moves listed by the moves command.
+type moveFilter struct {
+	typeName    string // only list moves of this type, empty means all types
+	damageClass string // only list moves of this damage class, empty means all classes
+}
+
+// matches reports whether move passes the filter.
+func (f moveFilter) matches(move pokeapi.Move) bool {
+	if f.typeName != "" && move.Type.Name != f.typeName {
+		return false
+	}
+	if f.damageClass != "" && move.DamageClass.Name != f.damageClass {
+		return false
+	}
+	return true
+}
+
+// commandMoves lists the moves a caught Pokemon can learn with their battle
+// data, optionally only those of a type or damage class. Every move is looked
+// up, a few at a time, which takes a while the first time; Ctrl-C cancels the
+// lookup. Moves that can't be looked up are reported after the list.
+//
+// Usage: moves <pokemon_name> [--type <type_name>] [--class physical|special|status]
+func commandMoves(ctx context.Context, cfg *config, args ...string) error {
+	name, filter, err := parseMovesArgs(args)
+	if err != nil {
+		return err
+	}
+
+	pokemon, ok := cfg.caughtPokemon[name]
+	if !ok {
+		return fmt.Errorf("you have not caught %s yet", name)
+	}
+
+	names := make([]string, 0, len(pokemon.Moves))
+	for _, learnable := range pokemon.Moves {
+		names = append(names, learnable.Move.Name)
+	}
+	found, failed, err := lookupMoves(ctx, &cfg.pokeapiClient, names)
+	if err != nil {
+		return err
+	}
+
+	moves := make([]pokeapi.Move, 0, len(found))
+	for _, move := range found {
+		if filter.matches(move) {
+			moves = append(moves, move)
+		}
+	}
+	sort.Slice(moves, func(i, j int) bool {
+		return moves[i].Name < moves[j].Name
+	})
+
+	fmt.Printf("  %-20s %-10s %-9s %5s %8s %3s\n", "move", "type", "class", "power", "accuracy", "pp")
+	for _, move := range moves {
+		fmt.Printf("  %-20s %-10s %-9s %5s %8s %3s\n",
+			move.Name, move.Type.Name, move.DamageClass.Name,
+			optionalValue(move.Power, ""), optionalValue(move.Accuracy, "%"), optionalValue(move.PP, ""))
+	}
+	fmt.Printf("%d of %d moves\n", len(moves), len(pokemon.Moves))
+	if len(failed) > 0 {
+		fmt.Printf("Could not look up %d moves: %s\n", len(failed), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// lookupMoves looks up the named moves. The moves that can't be looked up
+// are left out and their names returned in failed, so one bad move doesn't
+// hide the others. err is only set when ctx is cancelled, or when no move
+// could be looked up at all, e.g. offline.
+func lookupMoves(ctx context.Context, client *pokeapi.Client, names []string) (moves []pokeapi.Move, failed []string, err error) {
+	found, errs, err := client.GetMovesContext(ctx, names)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var firstErr error
+	for i, name := range names {
+		if errs[i] != nil {
+			failed = append(failed, name)
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+			continue
+		}
+		moves = append(moves, found[i])
+	}
+	if len(moves) == 0 && firstErr != nil {
+		return nil, nil, firstErr
+	}
+	return moves, failed, nil
+}
+
+// parseMovesArgs converts the moves command arguments into the Pokemon name
+// and a moveFilter.
+func parseMovesArgs(args []string) (string, moveFilter, error) {
+	usage := errors.New("usage: moves <pokemon_name> [--type <type_name>] [--class physical|special|status]")
+	if len(args) == 0 {
+		return "", moveFilter{}, usage
+	}
+
+	filter := moveFilter{}
+	flags := map[string]*string{"--type": &filter.typeName, "--class": &filter.damageClass}
+	if err := parseFlags(args[1:], flags, usage); err != nil {
+		return "", moveFilter{}, err
+	}
+	if filter.damageClass != "" && !damageClasses[filter.damageClass] {
+		return "", moveFilter{}, fmt.Errorf("unknown damage class: %s, expected physical, special or status", filter.damageClass)
+	}
+	return args[0], filter, nil
+}
+
+// optionalValue formats a value PokeAPI may leave out, such as the power of
+// status moves, as "-" when it is missing.
+func optionalValue(value *int, suffix string) string {
+	if value == nil {
+		return "-"
+	}
+	return strconv.Itoa(*value) + suffix
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// TestParseMovesArgs verifies the argument parsing of the moves command.
+func TestParseMovesArgs(t *testing.T) {
+	cases := []struct {
+		args     []string
+		name     string
+		filter   moveFilter
+		hasError bool
+	}{
+		{args: []string{"pikachu"}, name: "pikachu"},
+		{args: []string{"pikachu", "--type", "electric"}, name: "pikachu", filter: moveFilter{typeName: "electric"}},
+		{args: []string{"pikachu", "--class", "status", "--type", "normal"}, name: "pikachu", filter: moveFilter{typeName: "normal", damageClass: "status"}},
+		{args: []string{}, hasError: true},
+		{args: []string{"pikachu", "--type"}, hasError: true},
+		{args: []string{"pikachu", "--class", "magic"}, hasError: true},
+		{args: []string{"pikachu", "raichu"}, hasError: true},
+	}
+
+	for _, c := range cases {
+		name, filter, err := parseMovesArgs(c.args)
+		if c.hasError {
+			if err == nil {
+				t.Errorf("parseMovesArgs(%v): expected an error", c.args)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseMovesArgs(%v): unexpected error: %v", c.args, err)
+			continue
+		}
+		if name != c.name || filter != c.filter {
+			t.Errorf("parseMovesArgs(%v) == %q, %+v, expected %q, %+v", c.args, name, filter, c.name, c.filter)
+		}
+	}
+}
+
+// TestLookupMoves verifies that moves that can't be looked up are skipped
+// instead of failing the whole lookup.
+func TestLookupMoves(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/move/")
+		if name == "missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"name": "` + name + `", "type": {"name": "normal"}}`))
+	}))
+	defer server.Close()
+
+	client := pokeapi.NewClient(pokeapi.WithBaseURL(server.URL), pokeapi.WithCache(nil), pokeapi.WithRetryPolicy(pokeapi.RetryPolicy{}), pokeapi.WithRateLimit(0, 0))
+	defer client.Close()
+
+	moves, failed, err := lookupMoves(context.Background(), &client, []string{"tackle", "missing", "growl", "scratch", "ember"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(moves) != 4 || moves[0].Name != "tackle" || moves[3].Name != "ember" {
+		t.Errorf("expected the four known moves in order, got %+v", moves)
+	}
+	if len(failed) != 1 || failed[0] != "missing" {
+		t.Errorf("expected missing to fail, got %v", failed)
+	}
+
+	if _, _, err := lookupMoves(context.Background(), &client, []string{"missing"}); !errors.Is(err, pokeapi.ErrNotFound) {
+		t.Errorf("expected ErrNotFound when no move can be looked up, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := lookupMoves(ctx, &client, []string{"tackle"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
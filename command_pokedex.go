@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// pokedexOptions holds the filters and sorting requested for the pokedex command.
+type pokedexOptions struct {
+	typeName string // only list Pokemon of this type, empty means all types
+	sortStat string // sort by this base stat (highest first), empty means by dex ID
+}
+
+// commandPokedex lists the caught Pokemon, ordered by national dex ID unless
+// another sort order is requested, followed by a completion summary.
+//
+// Usage: pokedex [--type <type_name>] [--sort <stat_name>]
+func commandPokedex(ctx context.Context, cfg *config, args ...string) error {
+	opts, err := parsePokedexArgs(args)
+	if err != nil {
+		return err
+	}
+
+	if len(cfg.caughtPokemon) == 0 {
+		fmt.Println("Your Pokedex is empty, go catch some pokemon!")
+		return nil
+	}
+
+	pokemon, err := listPokedex(cfg.caughtPokemon, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Your Pokedex:")
+	if len(pokemon) == 0 {
+		fmt.Printf("  no caught pokemon of type %s\n", opts.typeName)
+	}
+	for _, p := range pokemon {
+		if opts.sortStat != "" {
+			fmt.Printf("  #%04d %s (%s %d)\n", p.ID, p.Name, opts.sortStat, baseStat(p, opts.sortStat))
+			continue
+		}
+		fmt.Printf("  #%04d %s\n", p.ID, p.Name)
+	}
+
+	// The total number of species comes from the list endpoint, so the
+	// summary degrades gracefully when the API can't be reached.
+	speciesResp, err := cfg.pokeapiClient.ListPokemonSpeciesContext(ctx, nil)
+	if err != nil || speciesResp.Count == 0 {
+		fmt.Printf("Caught %d pokemon\n", len(cfg.caughtPokemon))
+		return nil
+	}
+	species := min(caughtSpecies(cfg.caughtPokemon), speciesResp.Count)
+	percent := float64(species) / float64(speciesResp.Count) * 100
+	fmt.Printf("Caught %d/%d species (%.1f%%)\n", species, speciesResp.Count, percent)
+	return nil
+}
+
+// caughtSpecies returns the number of distinct species among the caught
+// Pokemon, so forms like deoxys-attack and deoxys-speed count once.
+func caughtSpecies(caught map[string]pokeapi.Pokemon) int {
+	species := make(map[string]bool, len(caught))
+	for _, p := range caught {
+		name := p.Species.Name
+		if name == "" {
+			// Saved before the species was recorded, the name is the best guess
+			name = p.Name
+		}
+		species[name] = true
+	}
+	return len(species)
+}
+
+// parsePokedexArgs converts the pokedex command arguments into pokedexOptions.
+func parsePokedexArgs(args []string) (pokedexOptions, error) {
+	opts := pokedexOptions{}
+	flags := map[string]*string{"--type": &opts.typeName, "--sort": &opts.sortStat}
+	usage := errors.New("usage: pokedex [--type <type_name>] [--sort <stat_name>]")
+	if err := parseFlags(args, flags, usage); err != nil {
+		return pokedexOptions{}, err
+	}
+	return opts, nil
+}
+
+// listPokedex returns the caught Pokemon matching opts in display order.
+func listPokedex(caught map[string]pokeapi.Pokemon, opts pokedexOptions) ([]pokeapi.Pokemon, error) {
+	pokemon := make([]pokeapi.Pokemon, 0, len(caught))
+	knownStat := false
+	for _, p := range caught {
+		if opts.sortStat != "" && hasStat(p, opts.sortStat) {
+			knownStat = true
+		}
+		if opts.typeName != "" && !hasType(p, opts.typeName) {
+			continue
+		}
+		pokemon = append(pokemon, p)
+	}
+	if opts.sortStat != "" && !knownStat {
+		return nil, fmt.Errorf("unknown stat: %s", opts.sortStat)
+	}
+
+	sort.Slice(pokemon, func(i, j int) bool {
+		if opts.sortStat != "" {
+			a, b := baseStat(pokemon[i], opts.sortStat), baseStat(pokemon[j], opts.sortStat)
+			if a != b {
+				return a > b
+			}
+		}
+		return pokemon[i].ID < pokemon[j].ID
+	})
+	return pokemon, nil
+}
+
+// hasType reports whether the Pokemon has the given type in any slot.
+func hasType(pokemon pokeapi.Pokemon, typeName string) bool {
+	for _, t := range pokemon.Types {
+		if t.Type.Name == typeName {
+			return true
+		}
+	}
+	return false
+}
+
+// hasStat reports whether the Pokemon lists the given stat.
+func hasStat(pokemon pokeapi.Pokemon, statName string) bool {
+	for _, s := range pokemon.Stats {
+		if s.Stat.Name == statName {
+			return true
+		}
+	}
+	return false
+}
+
+// baseStat returns the Pokemon's base value for the given stat, or 0 if missing.
+func baseStat(pokemon pokeapi.Pokemon, statName string) int {
+	for _, s := range pokemon.Stats {
+		if s.Stat.Name == statName {
+			return s.BaseStat
+		}
+	}
+	return 0
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// TestParsePokedexArgs verifies the argument parsing of the pokedex command.
+func TestParsePokedexArgs(t *testing.T) {
+	cases := []struct {
+		args     []string
+		expected pokedexOptions
+		hasError bool
+	}{
+		{args: []string{}},
+		{args: []string{"--type", "fire"}, expected: pokedexOptions{typeName: "fire"}},
+		{args: []string{"--sort", "speed", "--type", "water"}, expected: pokedexOptions{typeName: "water", sortStat: "speed"}},
+		{args: []string{"--sort"}, hasError: true},
+		{args: []string{"--color", "red"}, hasError: true},
+		{args: []string{"pikachu"}, hasError: true},
+	}
+
+	for _, c := range cases {
+		opts, err := parsePokedexArgs(c.args)
+		if c.hasError {
+			if err == nil {
+				t.Errorf("parsePokedexArgs(%v): expected an error", c.args)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parsePokedexArgs(%v): unexpected error: %v", c.args, err)
+			continue
+		}
+		if opts != c.expected {
+			t.Errorf("parsePokedexArgs(%v) == %+v, expected %+v", c.args, opts, c.expected)
+		}
+	}
+}
+
+// TestListPokedex verifies the filtering and ordering of the caught Pokemon.
+func TestListPokedex(t *testing.T) {
+	dat := []byte(`{
+		"squirtle": {"id": 7, "name": "squirtle", "types": [{"type": {"name": "water"}}], "stats": [{"base_stat": 43, "stat": {"name": "speed"}}]},
+		"charmander": {"id": 4, "name": "charmander", "types": [{"type": {"name": "fire"}}], "stats": [{"base_stat": 65, "stat": {"name": "speed"}}]},
+		"psyduck": {"id": 54, "name": "psyduck", "types": [{"type": {"name": "water"}}], "stats": [{"base_stat": 55, "stat": {"name": "speed"}}]},
+		"bulbasaur": {"id": 1, "name": "bulbasaur", "types": [{"type": {"name": "grass"}}, {"type": {"name": "poison"}}], "stats": [{"base_stat": 45, "stat": {"name": "speed"}}]}
+	}`)
+	caught := map[string]pokeapi.Pokemon{}
+	if err := json.Unmarshal(dat, &caught); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		opts     pokedexOptions
+		expected []string
+		hasError bool
+	}{
+		// Without options, the Pokemon are ordered by dex ID
+		{expected: []string{"bulbasaur", "charmander", "squirtle", "psyduck"}},
+		{opts: pokedexOptions{typeName: "water"}, expected: []string{"squirtle", "psyduck"}},
+		{opts: pokedexOptions{typeName: "poison"}, expected: []string{"bulbasaur"}},
+		{opts: pokedexOptions{typeName: "dragon"}, expected: []string{}},
+		{opts: pokedexOptions{sortStat: "speed"}, expected: []string{"charmander", "psyduck", "bulbasaur", "squirtle"}},
+		{opts: pokedexOptions{typeName: "water", sortStat: "speed"}, expected: []string{"psyduck", "squirtle"}},
+		{opts: pokedexOptions{sortStat: "luck"}, hasError: true},
+	}
+
+	for _, c := range cases {
+		pokemon, err := listPokedex(caught, c.opts)
+		if c.hasError {
+			if err == nil {
+				t.Errorf("listPokedex(%+v): expected an error", c.opts)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("listPokedex(%+v): unexpected error: %v", c.opts, err)
+			continue
+		}
+		actual := []string{}
+		for _, p := range pokemon {
+			actual = append(actual, p.Name)
+		}
+		if len(actual) != len(c.expected) {
+			t.Errorf("listPokedex(%+v) == %v, expected %v", c.opts, actual, c.expected)
+			continue
+		}
+		for i := range actual {
+			if actual[i] != c.expected[i] {
+				t.Errorf("listPokedex(%+v) == %v, expected %v", c.opts, actual, c.expected)
+				break
+			}
+		}
+	}
+}
+
+// TestCaughtSpecies verifies that forms of one species are counted once.
+func TestCaughtSpecies(t *testing.T) {
+	dat := []byte(`{
+		"deoxys-attack": {"name": "deoxys-attack", "species": {"name": "deoxys"}},
+		"deoxys-speed": {"name": "deoxys-speed", "species": {"name": "deoxys"}},
+		"pikachu": {"name": "pikachu", "species": {"name": "pikachu"}},
+		"eevee": {"name": "eevee"}
+	}`)
+	caught := map[string]pokeapi.Pokemon{}
+	if err := json.Unmarshal(dat, &caught); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actual := caughtSpecies(caught); actual != 3 {
+		t.Errorf("caughtSpecies == %d, expected 3", actual)
+	}
+}
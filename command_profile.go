@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/masteidel/pokedexcli/internal/pokesave"
+)
+
+// commandProfile manages the trainer profiles sharing this machine.
+//
+// Usage: profile new|switch|delete <profile_name>, or profile list
+func commandProfile(ctx context.Context, cfg *config, args ...string) error {
+	if cfg.saves == nil {
+		return errors.New("no save file location is configured")
+	}
+	if len(args) == 0 {
+		return errors.New("usage: profile new|switch|list|delete [profile_name]")
+	}
+
+	if args[0] == "list" {
+		return listProfiles(cfg)
+	}
+
+	if len(args) != 2 {
+		return fmt.Errorf("you must provide a profile name: profile %s <profile_name>", args[0])
+	}
+	name := args[1]
+	if err := pokesave.ValidateProfileName(name); err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "new":
+		return newProfile(cfg, name)
+	case "switch":
+		return switchProfile(cfg, name)
+	case "delete":
+		return deleteProfile(cfg, name)
+	default:
+		return fmt.Errorf("unknown profile command: %s", args[0])
+	}
+}
+
+// listProfiles prints every saved profile, marking the active one.
+func listProfiles(cfg *config) error {
+	profiles, err := cfg.saves.List()
+	if err != nil {
+		return err
+	}
+
+	active := false
+	for _, name := range profiles {
+		if name == cfg.profile {
+			active = true
+			fmt.Printf("* %s\n", name)
+			continue
+		}
+		fmt.Printf("  %s\n", name)
+	}
+	// The active profile only appears on disk after its first save
+	if !active {
+		fmt.Printf("* %s (unsaved)\n", cfg.profile)
+	}
+	return nil
+}
+
+// newProfile saves the active profile and starts a fresh trainer called name.
+func newProfile(cfg *config, name string) error {
+	if name == cfg.profile || cfg.saves.Exists(name) {
+		return fmt.Errorf("profile %s already exists", name)
+	}
+	if err := saveSession(cfg); err != nil {
+		return err
+	}
+
+	cfg.profile = name
+	resetSession(cfg)
+	if err := saveSession(cfg); err != nil {
+		return err
+	}
+	fmt.Printf("Created profile %s\n", name)
+	return nil
+}
+
+// switchProfile saves the active profile and loads the profile called name.
+func switchProfile(cfg *config, name string) error {
+	if name == cfg.profile {
+		return fmt.Errorf("profile %s is already active", name)
+	}
+	if !cfg.saves.Exists(name) {
+		return fmt.Errorf("no profile named %s, create it with: profile new %s", name, name)
+	}
+	if err := saveSession(cfg); err != nil {
+		return err
+	}
+
+	previous := cfg.profile
+	cfg.profile = name
+	if err := loadSession(cfg); err != nil {
+		cfg.profile = previous
+		return err
+	}
+	fmt.Printf("Switched to profile %s (%d pokemon caught)\n", name, len(cfg.caughtPokemon))
+	return nil
+}
+
+// deleteProfile removes the saved profile called name. The active profile
+// can't be deleted.
+func deleteProfile(cfg *config, name string) error {
+	if name == cfg.profile {
+		return errors.New("you can't delete the active profile, switch to another one first")
+	}
+	if err := cfg.saves.Delete(name); err != nil {
+		return err
+	}
+	fmt.Printf("Deleted profile %s\n", name)
+	return nil
+}
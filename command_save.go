@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+	"github.com/masteidel/pokedexcli/internal/pokesave"
+)
+
+// commandSave writes the current session to the active profile's save file.
+func commandSave(ctx context.Context, cfg *config, args ...string) error {
+	if err := saveSession(cfg); err != nil {
+		return err
+	}
+	fmt.Printf("Saved %d pokemon to profile %s\n", len(cfg.caughtPokemon), cfg.profile)
+	return nil
+}
+
+// commandLoad replaces the current session with the active profile's save file.
+func commandLoad(ctx context.Context, cfg *config, args ...string) error {
+	if err := loadSession(cfg); err != nil {
+		return err
+	}
+	fmt.Printf("Loaded %d pokemon from profile %s\n", len(cfg.caughtPokemon), cfg.profile)
+	return nil
+}
+
+// saveSession persists the caught Pokemon, inventory and map position of cfg
+// to the active profile.
+func saveSession(cfg *config) error {
+	if cfg.saves == nil {
+		return errors.New("no save file location is configured")
+	}
+	return cfg.saves.Save(cfg.profile, pokesave.State{
+		CaughtPokemon:    cfg.caughtPokemon,
+		NextLocationsURL: cfg.locations.NextURL(),
+		PrevLocationsURL: cfg.locations.PrevURL(),
+		Inventory:        cfg.inventory,
+	})
+}
+
+// loadSession restores the caught Pokemon, inventory and map position of cfg
+// from the active profile.
+func loadSession(cfg *config) error {
+	if cfg.saves == nil {
+		return errors.New("no save file location is configured")
+	}
+	state, err := cfg.saves.Load(cfg.profile)
+	if err != nil {
+		return err
+	}
+	cfg.caughtPokemon = state.CaughtPokemon
+	cfg.locations.Restore(state.NextLocationsURL, state.PrevLocationsURL)
+	cfg.inventory = state.Inventory
+	return nil
+}
+
+// resetSession clears cfg to the state of a brand new trainer.
+func resetSession(cfg *config) {
+	cfg.caughtPokemon = map[string]pokeapi.Pokemon{}
+	cfg.locations.Restore(nil, nil)
+	cfg.inventory = map[string]int{}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// fallbackLanguage is used for texts that aren't translated into the
+// language the user asked for.
+const fallbackLanguage = "en"
+
+// commandSpecies prints the Pokedex entry of a species and lists its forms.
+// Pokemon names work as well, so e.g. "deoxys-attack" shows the deoxys species.
+//
+// Usage: species <name>
+func commandSpecies(ctx context.Context, cfg *config, args ...string) error {
+	if len(args) != 1 {
+		return errors.New("you must provide a species name")
+	}
+
+	name := args[0]
+	species, err := getSpecies(ctx, cfg, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no species named %s", name)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("#%04d %s", species.ID, species.LocalizedName(cfg.language))
+	if genus := localized(species.Genus, cfg.language); genus != "" {
+		fmt.Printf(", the %s", genus)
+	}
+	fmt.Println()
+
+	for _, tag := range speciesTags(species) {
+		fmt.Printf("  [%s]\n", tag)
+	}
+	if text := localized(species.FlavorText, cfg.language); text != "" {
+		fmt.Printf("  %s\n", text)
+	}
+
+	fmt.Printf("Generation: %s\n", species.Generation.Name)
+	fmt.Printf("Capture rate: %d/255\n", species.CaptureRate)
+	if species.BaseHappiness != nil {
+		fmt.Printf("Base happiness: %d/255\n", *species.BaseHappiness)
+	}
+	fmt.Printf("Growth rate: %s\n", species.GrowthRate.Name)
+	if species.EvolvesFromSpecies != nil {
+		fmt.Printf("Evolves from: %s\n", species.EvolvesFromSpecies.Name)
+	}
+
+	fmt.Println("Forms:")
+	for _, variety := range species.Varieties {
+		if variety.IsDefault {
+			fmt.Printf("  - %s (default)\n", variety.Pokemon.Name)
+			continue
+		}
+		fmt.Printf("  - %s\n", variety.Pokemon.Name)
+	}
+	return nil
+}
+
+// getSpecies looks up a species by name. If there is no species of that
+// name, it is looked up as a Pokemon, whose species is returned instead.
+func getSpecies(ctx context.Context, cfg *config, name string) (pokeapi.PokemonSpecies, error) {
+	species, err := cfg.pokeapiClient.GetPokemonSpeciesContext(ctx, name)
+	if !errors.Is(err, pokeapi.ErrNotFound) {
+		return species, err
+	}
+
+	pokemon, pokemonErr := cfg.pokeapiClient.GetPokemonContext(ctx, name)
+	if pokemonErr != nil {
+		// Report the species lookup, not the fallback
+		return pokeapi.PokemonSpecies{}, err
+	}
+	return cfg.pokeapiClient.GetPokemonSpeciesContext(ctx, pokemon.Species.Name)
+}
+
+// localized returns text in language, falling back to fallbackLanguage when
+// there is no such translation.
+func localized(text func(language string) string, language string) string {
+	if s := text(language); s != "" {
+		return s
+	}
+	return text(fallbackLanguage)
+}
+
+// speciesTags returns the special categories the species belongs to.
+func speciesTags(species pokeapi.PokemonSpecies) []string {
+	var tags []string
+	if species.IsLegendary {
+		tags = append(tags, "legendary")
+	}
+	if species.IsMythical {
+		tags = append(tags, "mythical")
+	}
+	if species.IsBaby {
+		tags = append(tags, "baby")
+	}
+	return tags
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// TestGetSpecies verifies that Pokemon names are looked up as the species
+// they belong to.
+func TestGetSpecies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pokemon-species/deoxys":
+			w.Write([]byte(`{"id": 386, "name": "deoxys"}`))
+		case "/pokemon/deoxys-attack":
+			w.Write([]byte(`{"id": 10001, "name": "deoxys-attack", "species": {"name": "deoxys"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &config{
+		pokeapiClient: pokeapi.NewClient(pokeapi.WithBaseURL(server.URL), pokeapi.WithCache(nil), pokeapi.WithRetryPolicy(pokeapi.RetryPolicy{})),
+	}
+	defer cfg.pokeapiClient.Close()
+
+	cases := []struct {
+		name     string
+		expected string
+		hasError bool
+	}{
+		{name: "deoxys", expected: "deoxys"},
+		{name: "deoxys-attack", expected: "deoxys"},
+		{name: "missingno", hasError: true},
+	}
+
+	for _, c := range cases {
+		species, err := getSpecies(context.Background(), cfg, c.name)
+		if c.hasError {
+			// The species lookup is reported, not the Pokemon fallback
+			if !errors.Is(err, pokeapi.ErrNotFound) {
+				t.Errorf("getSpecies(%s): expected ErrNotFound, got %v", c.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("getSpecies(%s): unexpected error: %v", c.name, err)
+			continue
+		}
+		if species.Name != c.expected {
+			t.Errorf("getSpecies(%s) == %s, expected %s", c.name, species.Name, c.expected)
+		}
+	}
+}
+
+// TestLocalized verifies that missing translations fall back to fallbackLanguage.
+func TestLocalized(t *testing.T) {
+	species := pokeapi.PokemonSpecies{Genera: []pokeapi.Genus{
+		{Genus: "Seed Pokemon", Language: pokeapi.NamedAPIResource{Name: "en"}},
+		{Genus: "Pokémon Graine", Language: pokeapi.NamedAPIResource{Name: "fr"}},
+	}}
+
+	cases := []struct {
+		language string
+		expected string
+	}{
+		{language: "fr", expected: "Pokémon Graine"},
+		{language: "en", expected: "Seed Pokemon"},
+		{language: "ja", expected: "Seed Pokemon"},
+	}
+
+	for _, c := range cases {
+		if actual := localized(species.Genus, c.language); actual != c.expected {
+			t.Errorf("localized(Genus, %s) == %q, expected %q", c.language, actual, c.expected)
+		}
+	}
+	if actual := localized(pokeapi.PokemonSpecies{}.Genus, "fr"); actual != "" {
+		t.Errorf("expected no text without any translation, got %q", actual)
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// commandSync downloads the PokeAPI data the Pokedex uses into the offline
+// bundle, so it can be used without a network connection via --offline.
+// Ctrl-C cancels the download and keeps the previous bundle.
+func commandSync(ctx context.Context, cfg *config, args ...string) error {
+	if cfg.pokeapiClient.Offline() {
+		return errors.New("sync needs a network connection, restart without --offline")
+	}
+	if cfg.bundlePath == "" {
+		return errors.New("no location for the bundle, restart with --bundle <path>")
+	}
+
+	fmt.Printf("Downloading PokeAPI data to %s, this takes a while...\n", cfg.bundlePath)
+	manifest, err := cfg.pokeapiClient.Sync(ctx, cfg.bundlePath, func(p pokeapi.SyncProgress) {
+		// Rewrite the progress line in place, ending it once a resource is complete
+		fmt.Printf("\r  %s: %d/%d", p.Resource, p.Done, p.Total)
+		if p.Done == p.Total {
+			fmt.Println()
+		}
+	})
+	if err != nil {
+		fmt.Println()
+		return err
+	}
+
+	fmt.Printf("Synced %d responses, start with --offline to use them\n", manifest.Entries)
+	return nil
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// multiplierOrder is the order the weakness command lists multipliers in,
+// from the most to the least damage taken. Regular damage isn't listed.
+var multiplierOrder = []float64{4, 2, 0.5, 0.25, 0}
+
+// commandWeakness lists the attacking types that deal more or less than
+// regular damage to a Pokemon, combining both types of dual-typed Pokemon.
+//
+// Usage: weakness <pokemon_name>
+func commandWeakness(ctx context.Context, cfg *config, args ...string) error {
+	if len(args) != 1 {
+		return errors.New("you must provide a pokemon name")
+	}
+
+	pokemon, err := findPokemon(ctx, cfg, args[0])
+	if err != nil {
+		return err
+	}
+
+	defending := make([]pokeapi.Type, 0, len(pokemon.Types))
+	for _, name := range pokemonTypes(pokemon) {
+		t, err := cfg.pokeapiClient.GetTypeContext(ctx, name)
+		if err != nil {
+			return err
+		}
+		defending = append(defending, t)
+	}
+
+	fmt.Printf("%s (%s) takes:\n", pokemon.Name, strings.Join(pokemonTypes(pokemon), "/"))
+	byMultiplier := weaknesses(defending)
+	for _, m := range multiplierOrder {
+		if names := byMultiplier[m]; len(names) > 0 {
+			fmt.Printf("  %s from %s\n", formatMultiplier(m), strings.Join(names, ", "))
+		}
+	}
+	fmt.Println("  1x from every other type")
+	return nil
+}
+
+// commandMatchup computes how effective moves of a type are against a Pokemon.
+//
+// Usage: matchup <attacking_type> <pokemon_name>
+func commandMatchup(ctx context.Context, cfg *config, args ...string) error {
+	if len(args) != 2 {
+		return errors.New("usage: matchup <attacking_type> <pokemon_name>")
+	}
+
+	attack, err := cfg.pokeapiClient.GetTypeContext(ctx, args[0])
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return fmt.Errorf("no type named %s", args[0])
+	}
+	if err != nil {
+		return err
+	}
+	pokemon, err := findPokemon(ctx, cfg, args[1])
+	if err != nil {
+		return err
+	}
+
+	// The attacking type's relations cover every defending type, so the
+	// Pokemon's types don't need to be looked up
+	types := pokemonTypes(pokemon)
+	multiplier := 1.0
+	for _, name := range types {
+		multiplier *= attack.MultiplierTo(name)
+	}
+
+	fmt.Printf("%s against %s (%s): %s, %s\n", attack.Name, pokemon.Name, strings.Join(types, "/"),
+		formatMultiplier(multiplier), effectiveness(multiplier))
+	return nil
+}
+
+// findPokemon returns the caught Pokemon called name, or looks it up if it
+// hasn't been caught.
+func findPokemon(ctx context.Context, cfg *config, name string) (pokeapi.Pokemon, error) {
+	if pokemon, ok := cfg.caughtPokemon[name]; ok {
+		return pokemon, nil
+	}
+	pokemon, err := cfg.pokeapiClient.GetPokemonContext(ctx, name)
+	if errors.Is(err, pokeapi.ErrNotFound) {
+		return pokeapi.Pokemon{}, fmt.Errorf("no Pokemon named %s", name)
+	}
+	return pokemon, err
+}
+
+// pokemonTypes returns the type names of a Pokemon, primary type first.
+func pokemonTypes(pokemon pokeapi.Pokemon) []string {
+	types := slices.Clone(pokemon.Types)
+	sort.SliceStable(types, func(i, j int) bool {
+		return types[i].Slot < types[j].Slot
+	})
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, t.Type.Name)
+	}
+	return names
+}
+
+// weaknesses groups the attacking types that don't deal regular damage to a
+// Pokemon of the defending types by their combined multiplier. The types of
+// each group are sorted by name.
+func weaknesses(defending []pokeapi.Type) map[float64][]string {
+	// Only types listed in a relation can deal anything but regular damage
+	attacking := map[string]bool{}
+	for _, t := range defending {
+		relations := t.DamageRelations
+		for _, list := range [][]pokeapi.NamedAPIResource{relations.NoDamageFrom, relations.HalfDamageFrom, relations.DoubleDamageFrom} {
+			for _, r := range list {
+				attacking[r.Name] = true
+			}
+		}
+	}
+
+	byMultiplier := map[float64][]string{}
+	for name := range attacking {
+		m := pokeapi.DefenseMultiplier(name, defending)
+		if m != 1 {
+			byMultiplier[m] = append(byMultiplier[m], name)
+		}
+	}
+	for _, names := range byMultiplier {
+		sort.Strings(names)
+	}
+	return byMultiplier
+}
+
+// formatMultiplier formats a damage multiplier, e.g. "0.25x".
+func formatMultiplier(m float64) string {
+	return strconv.FormatFloat(m, 'f', -1, 64) + "x"
+}
+
+// effectiveness describes a damage multiplier the way the games do.
+func effectiveness(m float64) string {
+	switch {
+	case m == 0:
+		return "it has no effect"
+	case m < 1:
+		return "it's not very effective"
+	case m > 1:
+		return "it's super effective"
+	default:
+		return "regular damage"
+	}
+}
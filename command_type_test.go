@@ -0,0 +1,45 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// TestWeaknesses verifies that the multipliers of dual types are combined and grouped.
+func TestWeaknesses(t *testing.T) {
+	named := func(names ...string) []pokeapi.NamedAPIResource {
+		resources := []pokeapi.NamedAPIResource{}
+		for _, name := range names {
+			resources = append(resources, pokeapi.NamedAPIResource{Name: name})
+		}
+		return resources
+	}
+	grass := pokeapi.Type{Name: "grass", DamageRelations: pokeapi.TypeRelations{
+		HalfDamageFrom:   named("ground", "water", "grass", "electric"),
+		DoubleDamageFrom: named("flying", "poison", "bug", "fire", "ice"),
+	}}
+	poison := pokeapi.Type{Name: "poison", DamageRelations: pokeapi.TypeRelations{
+		HalfDamageFrom:   named("fighting", "poison", "bug", "grass", "fairy"),
+		DoubleDamageFrom: named("ground", "psychic"),
+	}}
+
+	expected := map[float64][]string{
+		2:    {"fire", "flying", "ice", "psychic"},
+		0.5:  {"electric", "fairy", "fighting", "water"},
+		0.25: {"grass"},
+	}
+	if actual := weaknesses([]pokeapi.Type{grass, poison}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("weaknesses(grass/poison) == %v, expected %v", actual, expected)
+	}
+}
+
+// TestFormatMultiplier verifies that multipliers are shown without trailing zeros.
+func TestFormatMultiplier(t *testing.T) {
+	for m, expected := range map[float64]string{4: "4x", 0.5: "0.5x", 0.25: "0.25x", 0: "0x"} {
+		if actual := formatMultiplier(m); actual != expected {
+			t.Errorf("formatMultiplier(%v) == %q, expected %q", m, actual, expected)
+		}
+	}
+}
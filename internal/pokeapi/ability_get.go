@@ -0,0 +1,15 @@
+package pokeapi
+
+import "context"
+
+// GetAbility retrieves an ability by its name or ID.
+func (c *Client) GetAbility(abilityName string) (Ability, error) {
+	return c.GetAbilityContext(context.Background(), abilityName)
+}
+
+// GetAbilityContext is like GetAbility, but the request is abandoned as soon
+// as ctx is cancelled.
+func (c *Client) GetAbilityContext(ctx context.Context, abilityName string) (Ability, error) {
+	url := c.baseURL + "/ability/" + abilityName
+	return fetch[Ability](ctx, c, url, abilityTTL)
+}
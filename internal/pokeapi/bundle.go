@@ -0,0 +1,119 @@
+package pokeapi
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// A bundle is a zip archive of raw PokeAPI responses, written by Client.Sync
+// and served by a client created with WithBundle. Each response is stored
+// under its URL relative to the API base URL, e.g.
+// "location-area/canalave-city-area" or "location-area?offset=20&limit=20".
+// Responses are read from the archive on demand, so the whole bundle never
+// has to fit in memory.
+// List pages are stored with DefaultPageSize resources per page only, so
+// offline paging needs pagers with that page size.
+
+// bundleVersion is the layout version written by Sync and read by OpenBundle.
+// Version 2 stores the first page of a list under its offset and limit,
+// e.g. "location-area?offset=0&limit=20", instead of "location-area".
+const bundleVersion = 2
+
+// bundleManifestName is the archive entry holding the BundleManifest.
+const bundleManifestName = "manifest.json"
+
+// BundleManifest describes the contents of a bundle.
+type BundleManifest struct {
+	Version  int       `json:"version"`   // layout version of the bundle
+	BaseURL  string    `json:"base_url"`  // API base URL the responses were downloaded from
+	SyncedAt time.Time `json:"synced_at"` // time the bundle was written
+	Entries  int       `json:"entries"`   // number of stored responses
+}
+
+// Bundle is an open bundle file.
+type Bundle struct {
+	Manifest BundleManifest // Manifest describes the bundle
+
+	archive *zip.ReadCloser      // archive is the open zip file
+	files   map[string]*zip.File // files maps the response keys to their archive entries
+}
+
+// OpenBundle opens the bundle file at path.
+func OpenBundle(path string) (*Bundle, error) {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Bundle{
+		archive: archive,
+		files:   make(map[string]*zip.File, len(archive.File)),
+	}
+	for _, f := range archive.File {
+		b.files[f.Name] = f
+	}
+
+	manifest, err := b.read(bundleManifestName)
+	if err != nil {
+		archive.Close()
+		return nil, fmt.Errorf("%s is not a pokedex bundle: %w", path, err)
+	}
+	if err := json.Unmarshal(manifest, &b.Manifest); err != nil {
+		archive.Close()
+		return nil, fmt.Errorf("%s is not a pokedex bundle: %w", path, err)
+	}
+	if b.Manifest.Version != bundleVersion {
+		archive.Close()
+		return nil, fmt.Errorf("%s has unsupported bundle version %d", path, b.Manifest.Version)
+	}
+	delete(b.files, bundleManifestName)
+	return b, nil
+}
+
+// Len returns the number of responses in the bundle.
+func (b *Bundle) Len() int {
+	return len(b.files)
+}
+
+// Close closes the bundle file.
+func (b *Bundle) Close() error {
+	return b.archive.Close()
+}
+
+// get returns the response stored under key, or an *OfflineError if the
+// bundle doesn't have it.
+func (b *Bundle) get(key string) ([]byte, error) {
+	if _, ok := b.files[key]; !ok {
+		return nil, &OfflineError{Key: key}
+	}
+	return b.read(key)
+}
+
+// read returns the contents of the archive entry name.
+func (b *Bundle) read(name string) ([]byte, error) {
+	f, ok := b.files[name]
+	if !ok {
+		return nil, fmt.Errorf("missing %s", name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// bundleKey returns the key a response for url is stored under in a bundle.
+// The base URL of the bundle is stripped as well as the client's own, so
+// the page links stored in a bundle work with any --api-url.
+func (c *Client) bundleKey(url string) string {
+	key := strings.TrimPrefix(url, c.baseURL)
+	if c.bundle != nil {
+		key = strings.TrimPrefix(key, c.bundle.Manifest.BaseURL)
+	}
+	return strings.Trim(key, "/")
+}
@@ -2,31 +2,191 @@
 package pokeapi
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/masteidel/pokedexcli/internal/pokecache"
 )
 
+// Cache is the storage the Client uses for raw API responses, keyed by URL.
+// pokecache provides in-memory, on-disk, tiered and no-op implementations.
+type Cache interface {
+	Get(key string) ([]byte, bool) // Get fetches the value stored under key
+	Add(key string, value []byte)  // Add stores value under key
+	Delete(key string)             // Delete removes the value stored under key
+	Len() int                      // Len returns the number of stored values
+	Close() error                  // Close releases the resources held by the cache
+}
+
+// ttlCache is implemented by caches that can store entries with their own TTL.
+// Caches without it keep every entry for their default lifetime.
+type ttlCache interface {
+	AddWithTTL(key string, value []byte, ttl time.Duration)
+}
+
+// entryCache is implemented by caches that remember the HTTP validators of
+// a response and keep expired entries around, so the client can revalidate
+// them with a conditional request instead of downloading them again.
+type entryCache interface {
+	AddEntry(key string, entry pokecache.Entry, ttl time.Duration)
+	Peek(key string) (pokecache.Entry, bool)
+}
+
+// staleCache is implemented by caches that can serve expired entries. When the
+// cache returns a stale value, the client serves it and refreshes it in the
+// background (stale-while-revalidate).
+type staleCache interface {
+	GetStale(key string) (val []byte, stale bool, ok bool)
+}
+
 // Client is a type that represents a custom HTTP client for making requests.
 type Client struct {
-	cache      pokecache.Cache // cache is  used for storing and retrieving data to reduce network requests
-	httpClient http.Client     // httpClient makes the http requests to PokeAPI
+	baseURL      string          // baseURL is the starting point for all API endpoints
+	userAgent    string          // userAgent is sent with every request, empty leaves Go's default
+	cache        Cache           // cache is  used for storing and retrieving data to reduce network requests
+	httpClient   http.Client     // httpClient makes the http requests to PokeAPI
+	revalidating *sync.Map       // revalidating holds the URLs being refreshed in the background
+	background   *sync.WaitGroup // background tracks the running background refreshes
+	inflight     *flightGroup    // inflight coalesces concurrent requests for the same URL
+	retry        RetryPolicy     // retry controls how failed requests are retried
+	retrySleep   sleepFunc       // retrySleep waits between retries, replaced in tests
+	limiter      *rateLimiter    // limiter is shared by every request, nil disables rate limiting
+	bundle       *Bundle         // bundle serves every response in offline mode, nil means online
+
+	prefetch         PrefetchPolicy     // prefetch controls what is downloaded ahead of time
+	backgroundCtx    context.Context    // backgroundCtx is the context prefetches and refreshes run under
+	cancelBackground context.CancelFunc // cancelBackground stops the running prefetches and refreshes
 }
 
 // NewClient is a function that creates and returns a new Client object.
-// It accepts two parameters: timeout and cacheInterval, both of type time.Duration.
-func NewClient(timeout, cacheInterval time.Duration) Client {
-	// The function returns a new Client instance.
-	return Client{
-		// cache is initialized with a new Cache object, with cacheInterval as the argument.
-		// The pokecache.NewCache() function is assumed to return a new Cache instance.
-		cache: pokecache.NewCache(cacheInterval),
+// Without options, the client talks to the public PokeAPI with a 5-second
+// timeout and caches responses in memory; Options change these defaults.
+func NewClient(opts ...Option) Client {
+	// A new Client instance is created with the default settings.
+	c := Client{
+		baseURL:   DefaultBaseURL,
+		userAgent: defaultUserAgent,
 
 		// httpClient is assigned a value of a new http.Client object.
-		// The Timeout of this http.Client is set to the given timeout parameter.
+		// The Timeout of this http.Client is set to the default timeout.
 		httpClient: http.Client{
-			Timeout: timeout,
+			Timeout: defaultTimeout,
 		},
+
+		revalidating: &sync.Map{},
+		background:   &sync.WaitGroup{},
+		inflight:     newFlightGroup(),
+		retry:        DefaultRetryPolicy,
+		retrySleep:   sleep,
+		limiter:      newRateLimiter(defaultRatePerSecond, defaultRateBurst),
+		prefetch:     DefaultPrefetchPolicy,
 	}
+	c.backgroundCtx, c.cancelBackground = context.WithCancel(context.Background())
+
+	// The options override the defaults.
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	// The default cache is only created when no other cache was provided,
+	// so its reap loop isn't started for nothing.
+	if c.cache == nil {
+		c.cache = pokecache.NewCache(defaultCacheInterval)
+	}
+	return c
+}
+
+// Cache returns the cache the client stores responses in.
+func (c *Client) Cache() Cache {
+	return c.cache
 }
+
+// Close cancels the running prefetches and background refreshes, waits for
+// them to return and releases the client's cache, stopping any background
+// work it runs, and its offline bundle.
+func (c *Client) Close() error {
+	c.cancelBackground()
+	c.background.Wait()
+	err := c.cache.Close()
+	if c.bundle != nil {
+		err = errors.Join(err, c.bundle.Close())
+	}
+	return err
+}
+
+// Offline reports whether the client serves responses from a bundle only.
+func (c *Client) Offline() bool {
+	return c.bundle != nil
+}
+
+// cacheGet fetches the response cached for url. If the cache serves an
+// expired response, it is returned anyway and refreshed in the background
+// with the given ttl.
+func (c *Client) cacheGet(url string, ttl time.Duration) ([]byte, bool) {
+	sc, ok := c.cache.(staleCache)
+	if !ok {
+		return c.cache.Get(url)
+	}
+	val, stale, ok := sc.GetStale(url)
+	if ok && stale {
+		c.revalidate(url, ttl)
+	}
+	return val, ok
+}
+
+// cacheAdd stores the response for url in the cache for ttl, or for the
+// cache's default lifetime if it doesn't support per-entry TTLs. The
+// validators of the response are kept if the cache supports them.
+func (c *Client) cacheAdd(url string, entry pokecache.Entry, ttl time.Duration) {
+	if ec, ok := c.cache.(entryCache); ok {
+		ec.AddEntry(url, entry, ttl)
+		return
+	}
+	if tc, ok := c.cache.(ttlCache); ok {
+		tc.AddWithTTL(url, entry.Value, ttl)
+		return
+	}
+	c.cache.Add(url, entry.Value)
+}
+
+// cachePeek returns the cached response for url with its validators, even
+// if it has expired, when the cache supports it.
+func (c *Client) cachePeek(url string) (pokecache.Entry, bool) {
+	if ec, ok := c.cache.(entryCache); ok {
+		return ec.Peek(url)
+	}
+	return pokecache.Entry{}, false
+}
+
+// revalidate refreshes the cached response for url in a background goroutine.
+// Only one refresh per URL runs at a time, and failures leave the stale
+// response in place until it is reaped. Closing the client cancels the refresh.
+func (c *Client) revalidate(url string, ttl time.Duration) {
+	if _, running := c.revalidating.LoadOrStore(url, struct{}{}); running {
+		return
+	}
+
+	c.background.Add(1)
+	go func() {
+		defer c.background.Done()
+		defer c.revalidating.Delete(url)
+
+		entry, err := c.download(c.backgroundCtx, url)
+		if err != nil || !json.Valid(entry.Value) {
+			return
+		}
+		c.cacheAdd(url, entry, ttl)
+	}()
+}
+
+// The pokecache implementations must satisfy Cache.
+var (
+	_ Cache = (*pokecache.Cache)(nil)
+	_ Cache = (*pokecache.DiskCache)(nil)
+	_ Cache = (*pokecache.TieredCache)(nil)
+	_ Cache = pokecache.NoopCache{}
+)
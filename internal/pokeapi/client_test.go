@@ -0,0 +1,292 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/masteidel/pokedexcli/internal/pokecache"
+)
+
+func TestClientCacheBackends(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"count": 1, "results": [{"name": "canalave-city-area"}]}`))
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		name             string
+		cache            Cache
+		expectedRequests int
+	}{
+		{name: "memory", cache: pokecache.NewCache(time.Minute), expectedRequests: 1},
+		{name: "noop", cache: pokecache.NoopCache{}, expectedRequests: 2},
+		{name: "nil", cache: nil, expectedRequests: 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			requests = 0
+			client := NewClient(WithTimeout(time.Second), WithCache(c.cache))
+			defer client.Close()
+			for i := 0; i < 2; i++ {
+				resp, err := client.ListLocations(&server.URL)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(resp.Results) != 1 || resp.Results[0].Name != "canalave-city-area" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			}
+			if requests != c.expectedRequests {
+				t.Errorf("expected %d requests, got %d", c.expectedRequests, requests)
+			}
+		})
+	}
+}
+
+func TestStaleWhileRevalidate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"count": 1, "results": [{"name": "fresh-area"}]}`))
+	}))
+	defer server.Close()
+
+	cache := pokecache.NewBoundedCache(time.Minute, pokecache.Limits{StaleFor: time.Hour})
+	cache.AddWithTTL(server.URL, []byte(`{"count": 1, "results": [{"name": "stale-area"}]}`), -time.Second)
+
+	client := NewClient(WithTimeout(time.Second), WithCache(cache))
+	defer client.Close()
+
+	resp, err := client.ListLocations(&server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Results[0].Name != "stale-area" {
+		t.Errorf("expected the stale response to be served, got %s", resp.Results[0].Name)
+	}
+
+	client.background.Wait()
+	resp, err = client.ListLocations(&server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Results[0].Name != "fresh-area" {
+		t.Errorf("expected the refreshed response, got %s", resp.Results[0].Name)
+	}
+}
+
+func TestCloseCancelsRevalidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The refreshed response never arrives
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	cache := pokecache.NewBoundedCache(time.Minute, pokecache.Limits{StaleFor: time.Hour})
+	cache.AddWithTTL(server.URL, []byte(`{"count": 0, "results": []}`), -time.Second)
+	client := NewClient(WithTimeout(time.Minute), WithCache(cache))
+	if _, err := client.ListLocations(&server.URL); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		client.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close didn't cancel the running refresh")
+	}
+}
+
+func TestConditionalRequests(t *testing.T) {
+	var gotETag string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotETag = r.Header.Get("If-None-Match")
+		if gotETag == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"count": 1, "results": [{"name": "new-area"}]}`))
+	}))
+	defer server.Close()
+
+	// An expired entry without stale serving has to be revalidated before use
+	cache := pokecache.NewCache(time.Minute)
+	defer cache.Close()
+	cache.AddEntry(server.URL, pokecache.Entry{
+		Value: []byte(`{"count": 1, "results": [{"name": "cached-area"}]}`),
+		ETag:  `"v1"`,
+	}, -time.Second)
+
+	client := NewClient(WithTimeout(time.Second), WithCache(cache))
+	resp, err := client.ListLocations(&server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotETag != `"v1"` {
+		t.Errorf("expected If-None-Match %q, got %q", `"v1"`, gotETag)
+	}
+	if resp.Results[0].Name != "cached-area" {
+		t.Errorf("expected the revalidated response, got %s", resp.Results[0].Name)
+	}
+	if _, ok := cache.Get(server.URL); !ok {
+		t.Error("expected the revalidated entry to be fresh again")
+	}
+
+	// A changed resource is downloaded again and replaces the entry
+	cache.AddEntry(server.URL, pokecache.Entry{
+		Value: []byte(`{"count": 1, "results": [{"name": "cached-area"}]}`),
+		ETag:  `"v0"`,
+	}, -time.Second)
+	resp, err = client.ListLocations(&server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Results[0].Name != "new-area" {
+		t.Errorf("expected the changed response, got %s", resp.Results[0].Name)
+	}
+	if entry, _ := cache.Peek(server.URL); entry.ETag != `"v1"` {
+		t.Errorf("expected the new ETag to be cached, got %q", entry.ETag)
+	}
+}
+
+func TestConditionalRequestsAfterPromotion(t *testing.T) {
+	requests := 0
+	var gotETag string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		gotETag = r.Header.Get("If-None-Match")
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer server.Close()
+
+	// An entry left on disk by a previous run, served from disk once and then
+	// from the copy in memory
+	disk, err := pokecache.NewDiskCache(t.TempDir(), time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	disk.AddEntry(server.URL, pokecache.Entry{
+		Value: []byte(`{"count": 1, "results": [{"name": "cached-area"}]}`),
+		ETag:  `"v1"`,
+	}, 50*time.Millisecond)
+	client := NewClient(WithTimeout(time.Second), WithCache(pokecache.NewTieredCache(pokecache.NewCache(time.Hour), disk)))
+	defer client.Close()
+
+	if _, err := client.ListLocations(&server.URL); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("expected the fresh disk entry to be served, got %d requests", requests)
+	}
+
+	// Once expired, the promoted copy is revalidated instead of downloaded again
+	time.Sleep(60 * time.Millisecond)
+	resp, err := client.ListLocations(&server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 1 || gotETag != `"v1"` {
+		t.Errorf("expected one request with If-None-Match %q, got %d with %q", `"v1"`, requests, gotETag)
+	}
+	if resp.Results[0].Name != "cached-area" {
+		t.Errorf("expected the revalidated response, got %s", resp.Results[0].Name)
+	}
+}
+
+func TestHTTPErrors(t *testing.T) {
+	cases := []struct {
+		status   int
+		expected error
+	}{
+		{status: http.StatusNotFound, expected: ErrNotFound},
+		{status: http.StatusTooManyRequests, expected: ErrRateLimited},
+		{status: http.StatusServiceUnavailable, expected: ErrServer},
+	}
+
+	for _, c := range cases {
+		t.Run(http.StatusText(c.status), func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				http.Error(w, http.StatusText(c.status), c.status)
+			}))
+			defer server.Close()
+
+			client := NewClient(WithTimeout(time.Second), WithCache(pokecache.NewCache(time.Minute)), WithRetryPolicy(RetryPolicy{}))
+			defer client.Close()
+
+			for i := 0; i < 2; i++ {
+				_, err := client.ListLocations(&server.URL)
+				if !errors.Is(err, c.expected) {
+					t.Errorf("expected %v, got %v", c.expected, err)
+				}
+				var httpErr *HTTPError
+				if !errors.As(err, &httpErr) || httpErr.StatusCode != c.status || httpErr.URL != server.URL {
+					t.Errorf("expected an HTTPError for status %d, got %v", c.status, err)
+				}
+			}
+			if requests != 2 {
+				t.Errorf("expected error responses to not be cached, got %d requests", requests)
+			}
+		})
+	}
+}
+
+func TestContextCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := NewClient(WithTimeout(time.Minute), WithCache(nil))
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	_, err := client.ListLocationsContext(ctx, &server.URL)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestClientOptions(t *testing.T) {
+	var path, userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		userAgent = r.UserAgent()
+		w.Write([]byte(`{"id": 25, "name": "pikachu"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithBaseURL(server.URL+"/api/v2/"),
+		WithUserAgent("pokedex-test"),
+		WithCache(nil),
+	)
+	defer client.Close()
+
+	pokemon, err := client.GetPokemon("pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pokemon.ID != 25 {
+		t.Errorf("expected pikachu, got %+v", pokemon)
+	}
+	if path != "/api/v2/pokemon/pikachu" {
+		t.Errorf("expected request to the mirror, got path %s", path)
+	}
+	if userAgent != "pokedex-test" {
+		t.Errorf("expected user agent pokedex-test, got %s", userAgent)
+	}
+}
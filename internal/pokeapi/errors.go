@@ -0,0 +1,71 @@
+package pokeapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Sentinel errors wrapped by HTTPError, for use with errors.Is.
+var (
+	// ErrNotFound means the requested resource doesn't exist, e.g. a misspelled name.
+	ErrNotFound = errors.New("not found")
+
+	// ErrRateLimited means PokeAPI is refusing requests because too many were made.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrServer means PokeAPI failed to handle the request.
+	ErrServer = errors.New("server error")
+
+	// ErrOffline means the client is in offline mode and its bundle doesn't
+	// have the requested resource.
+	ErrOffline = errors.New("not available offline")
+)
+
+// HTTPError is returned by Client methods when PokeAPI answers with a
+// non-2xx status. Responses that fail this way are never cached.
+type HTTPError struct {
+	StatusCode int    // StatusCode is the HTTP status of the response
+	URL        string // URL is the requested URL
+
+	// RetryAfter is how long the server asked clients to wait before
+	// retrying, 0 if it didn't say
+	RetryAfter time.Duration
+}
+
+// Error describes the failed request.
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("GET %s: %d %s", e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// Unwrap returns the sentinel error matching the status code, if any, so
+// callers can check for ErrNotFound, ErrRateLimited or ErrServer with errors.Is.
+func (e *HTTPError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.StatusCode >= 500:
+		return ErrServer
+	default:
+		return nil
+	}
+}
+
+// OfflineError is returned by Client methods in offline mode when the
+// requested resource isn't in the bundle. No request is made instead.
+type OfflineError struct {
+	Key string // Key is the missing response, relative to the API base URL
+}
+
+// Error describes the missing resource.
+func (e *OfflineError) Error() string {
+	return fmt.Sprintf("%s is not in the offline bundle", e.Key)
+}
+
+// Unwrap returns ErrOffline, so callers can check for it with errors.Is.
+func (e *OfflineError) Unwrap() error {
+	return ErrOffline
+}
@@ -0,0 +1,19 @@
+package pokeapi
+
+import (
+	"context"
+	"strconv"
+)
+
+// GetEvolutionChain retrieves an evolution chain by its ID. Chains have no
+// name; the ID of a species' chain is given by PokemonSpecies.EvolutionChain.
+func (c *Client) GetEvolutionChain(chainID int) (EvolutionChain, error) {
+	return c.GetEvolutionChainContext(context.Background(), chainID)
+}
+
+// GetEvolutionChainContext is like GetEvolutionChain, but the request is
+// abandoned as soon as ctx is cancelled.
+func (c *Client) GetEvolutionChainContext(ctx context.Context, chainID int) (EvolutionChain, error) {
+	url := c.baseURL + "/evolution-chain/" + strconv.Itoa(chainID)
+	return fetch[EvolutionChain](ctx, c, url, evolutionChainTTL)
+}
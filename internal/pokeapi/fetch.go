@@ -0,0 +1,172 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/masteidel/pokedexcli/internal/pokecache"
+)
+
+// fetch retrieves the resource at url and decodes it into a T, giving up
+// when ctx is cancelled.
+// In offline mode the response is only looked up in the client's bundle.
+// Otherwise the response is looked up in the client cache first. Otherwise it is
+// downloaded, or revalidated if an expired copy is still cached, and cached
+// for ttl once it has been decoded successfully, so error responses and
+// responses that aren't a valid T are never cached.
+// Every endpoint method goes through fetch, so they all cache, decode and
+// report errors the same way.
+func fetch[T any](ctx context.Context, c *Client, url string, ttl time.Duration) (T, error) {
+	var zero T
+
+	// In offline mode the bundle is the only source of responses
+	if c.bundle != nil {
+		dat, err := c.bundle.get(c.bundleKey(url))
+		if err != nil {
+			return zero, err
+		}
+		var resp T
+		if err := json.Unmarshal(dat, &resp); err != nil {
+			return zero, err
+		}
+		return resp, nil
+	}
+
+	// Serve the response from the cache if possible
+	if dat, ok := c.cacheGet(url, ttl); ok {
+		var resp T
+		if err := json.Unmarshal(dat, &resp); err != nil {
+			return zero, err
+		}
+		return resp, nil
+	}
+
+	// Concurrent fetches of the same URL share one request and one cache write
+	dat, err := c.inflight.do(ctx, url, func(ctx context.Context) ([]byte, error) {
+		entry, err := c.download(ctx, url)
+		if err != nil {
+			return nil, err
+		}
+		var resp T
+		if err := json.Unmarshal(entry.Value, &resp); err != nil {
+			return nil, err
+		}
+		c.cacheAdd(url, entry, ttl)
+		return entry.Value, nil
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	// Every caller decodes its own copy, so callers never share slices or maps
+	var resp T
+	if err := json.Unmarshal(dat, &resp); err != nil {
+		return zero, err
+	}
+	return resp, nil
+}
+
+// download performs a GET request for url and returns the response body
+// with its validators. If an expired copy of the response is still cached,
+// the request is conditional, and a 304 Not Modified answer returns the
+// cached body without transferring it again.
+// A non-2xx response is reported as an *HTTPError. Requests wait for the
+// client's rate limiter, and failures that may be transient are retried
+// following the client's RetryPolicy.
+func (c *Client) download(ctx context.Context, url string) (pokecache.Entry, error) {
+	cached, _ := c.cachePeek(url)
+	for retry := 0; ; retry++ {
+		entry, err := c.downloadOnce(ctx, url, cached)
+		if err == nil || retry >= c.retry.MaxRetries || ctx.Err() != nil {
+			return entry, err
+		}
+
+		delay := c.retry.backoff(retry + 1)
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) {
+			if !retryable(httpErr.StatusCode) {
+				return pokecache.Entry{}, err
+			}
+			// The server knows best when it will accept requests again
+			if httpErr.RetryAfter > c.retry.MaxRetryAfter {
+				return pokecache.Entry{}, err
+			}
+			delay = max(delay, httpErr.RetryAfter)
+		} else if !transient(err) {
+			return pokecache.Entry{}, err
+		}
+
+		if err := c.retrySleep(ctx, delay); err != nil {
+			return pokecache.Entry{}, err
+		}
+	}
+}
+
+// downloadOnce performs a single GET request for url, made conditional on
+// the validators of cached when it holds a value.
+func (c *Client) downloadOnce(ctx context.Context, url string, cached pokecache.Entry) (pokecache.Entry, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return pokecache.Entry{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return pokecache.Entry{}, err
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	if cached.Value != nil {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return pokecache.Entry{}, err
+	}
+	defer resp.Body.Close()
+
+	// The cached body is still current, only its validators may have changed
+	if resp.StatusCode == http.StatusNotModified && cached.Value != nil {
+		return pokecache.Entry{
+			Value:        cached.Value,
+			ETag:         headerOr(resp, "ETag", cached.ETag),
+			LastModified: headerOr(resp, "Last-Modified", cached.LastModified),
+		}, nil
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return pokecache.Entry{}, &HTTPError{
+			StatusCode: resp.StatusCode,
+			URL:        url,
+			RetryAfter: parseRetryAfter(resp, time.Now()),
+		}
+	}
+
+	dat, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return pokecache.Entry{}, err
+	}
+	return pokecache.Entry{
+		Value:        dat,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
+
+// headerOr returns the header key of resp, or fallback if it is missing.
+func headerOr(resp *http.Response, key, fallback string) string {
+	if value := resp.Header.Get(key); value != "" {
+		return value
+	}
+	return fallback
+}
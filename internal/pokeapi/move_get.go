@@ -0,0 +1,36 @@
+package pokeapi
+
+import "context"
+
+// moveWorkers is how many moves GetMovesContext looks up at once. A Pokemon
+// can learn well over a hundred moves, so looking them up one after another
+// would leave the user waiting on a round trip per move the first time.
+const moveWorkers = 4
+
+// GetMove retrieves a move by its name or ID.
+func (c *Client) GetMove(moveName string) (Move, error) {
+	return c.GetMoveContext(context.Background(), moveName)
+}
+
+// GetMoveContext is like GetMove, but the request is abandoned as soon as
+// ctx is cancelled.
+func (c *Client) GetMoveContext(ctx context.Context, moveName string) (Move, error) {
+	url := c.baseURL + "/move/" + moveName
+	return fetch[Move](ctx, c, url, moveTTL)
+}
+
+// GetMovesContext retrieves the named moves, moveWorkers at a time. For every
+// name, in order, either the move or the error looking it up is returned, so
+// one move that can't be retrieved doesn't hide the others. If ctx is
+// cancelled, only its error is returned.
+func (c *Client) GetMovesContext(ctx context.Context, moveNames []string) ([]Move, []error, error) {
+	moves := make([]Move, len(moveNames))
+	errs := make([]error, len(moveNames))
+	forEach(ctx, moveWorkers, len(moveNames), func(i int) {
+		moves[i], errs[i] = c.GetMoveContext(ctx, moveNames[i])
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	return moves, errs, nil
+}
@@ -0,0 +1,98 @@
+package pokeapi
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/masteidel/pokedexcli/internal/pokecache"
+)
+
+// Option configures optional behavior of a Client created with NewClient.
+type Option func(*Client)
+
+// Defaults of a Client created by NewClient without options.
+const (
+	defaultTimeout       = 5 * time.Second
+	defaultCacheInterval = 5 * time.Minute
+	defaultUserAgent     = "pokedexcli"
+
+	// PokeAPI is free to use and asks its users to be polite, so requests
+	// are limited even without WithRateLimit.
+	defaultRatePerSecond = 10
+	defaultRateBurst     = 20
+)
+
+// WithBaseURL points the client at another PokeAPI deployment, such as a
+// self-hosted mirror or a test server, e.g. "http://localhost:8000/api/v2".
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTimeout sets the time limit of a single HTTP request.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.httpClient.Timeout = timeout
+	}
+}
+
+// WithTransport sets the http.RoundTripper used to make requests.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.httpClient.Transport = transport
+	}
+}
+
+// WithCache sets the cache responses are stored in. A nil cache disables caching.
+func WithCache(cache Cache) Option {
+	return func(c *Client) {
+		if cache == nil {
+			cache = pokecache.NoopCache{}
+		}
+		c.cache = cache
+	}
+}
+
+// WithRetryPolicy sets how failed requests are retried, instead of
+// DefaultRetryPolicy. A zero RetryPolicy disables retries.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithRateLimit limits the client to perSecond requests per second on
+// average, allowing bursts of up to burst requests. The limit is shared by
+// all endpoints. A perSecond of 0 disables rate limiting.
+func WithRateLimit(perSecond float64, burst int) Option {
+	return func(c *Client) {
+		c.limiter = newRateLimiter(perSecond, burst)
+	}
+}
+
+// WithBundle makes the client work offline: every response is served from
+// bundle, and resources missing from it fail with an *OfflineError instead
+// of being downloaded. The client closes the bundle when it is closed.
+func WithBundle(bundle *Bundle) Option {
+	return func(c *Client) {
+		c.bundle = bundle
+	}
+}
+
+// WithPrefetch sets what is downloaded in the background after a page of
+// locations is listed, instead of DefaultPrefetchPolicy. A zero
+// PrefetchPolicy disables prefetching.
+func WithPrefetch(policy PrefetchPolicy) Option {
+	return func(c *Client) {
+		c.prefetch = policy
+	}
+}
@@ -0,0 +1,265 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// DefaultPageSize is the number of resources per page PokeAPI uses when no
+// limit is given, and the page size of pagers created with a limit of 0.
+const DefaultPageSize = 20
+
+// ErrNoPage is returned by Pager methods asked for a page outside the list.
+var ErrNoPage = errors.New("no such page")
+
+// Page is one page of a list endpoint.
+type Page[T any] struct {
+	// Count is the total number of resources in the list.
+	Count int `json:"count"`
+
+	// Next is the URL of the next page of results, nil on the last page.
+	Next *string `json:"next"`
+
+	// Previous is the URL of the previous page of results, nil on the first page.
+	Previous *string `json:"previous"`
+
+	// Results holds the resources on this page.
+	Results []T `json:"results"`
+}
+
+// NamedAPIResource refers to a resource by name, as listed by most list endpoints.
+type NamedAPIResource struct {
+	Name string `json:"name"` // Name is the name of the resource
+	URL  string `json:"url"`  // URL is where the resource can be requested
+}
+
+// listTTLs is how long the pages of each list endpoint stay fresh in the
+// cache, for endpoints that differ from defaultListTTL.
+var listTTLs = map[string]time.Duration{
+	"location-area":   locationListTTL,
+	"pokemon-species": speciesListTTL,
+}
+
+// defaultListTTL is how long the pages of other list endpoints stay fresh.
+const defaultListTTL = 24 * time.Hour
+
+// Pager pages lazily through a list endpoint, requesting a page only when it
+// is asked for. Pages are fetched through the client, so they are cached,
+// rate limited and available offline like any other response.
+// A Pager keeps track of the current page and is not safe for concurrent use.
+type Pager[T any] struct {
+	client   *Client       // client fetches the pages
+	endpoint string        // endpoint is the list endpoint, e.g. "location-area"
+	limit    int           // limit is the number of resources per page
+	ttl      time.Duration // ttl is how long pages stay fresh in the cache
+
+	started bool // started is false until a page has been loaded or restored
+	offset  int  // offset is the index of the first resource of the current page
+	count   int  // count is the length of the list, -1 until a page has been loaded
+
+	loaded func(Page[T]) // loaded, if not nil, is called with every page Next, Prev and Page load
+}
+
+// NewPager returns a Pager over the list endpoint of c, e.g. "location-area",
+// with limit resources per page. A limit of 0 means DefaultPageSize.
+// No request is made until the first page is asked for.
+// Offline, only pagers with DefaultPageSize work, as Sync stores the pages
+// of that size only; other limits fail with an *OfflineError.
+func NewPager[T any](c *Client, endpoint string, limit int) *Pager[T] {
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	ttl, ok := listTTLs[endpoint]
+	if !ok {
+		ttl = defaultListTTL
+	}
+	return &Pager[T]{
+		client:   c,
+		endpoint: endpoint,
+		limit:    limit,
+		ttl:      ttl,
+		count:    -1,
+	}
+}
+
+// LocationAreaPager returns a Pager over the location areas with limit areas
+// per page. Offline, limit must be 0 or DefaultPageSize.
+// Like ListLocations, every loaded page warms the cache with the listed
+// areas if the prefetch policy asks for them.
+func (c *Client) LocationAreaPager(limit int) *Pager[NamedAPIResource] {
+	p := NewPager[NamedAPIResource](c, "location-area", limit)
+	p.loaded = func(page Page[NamedAPIResource]) {
+		c.prefetchLocations(page.Results)
+	}
+	return p
+}
+
+// PokemonSpeciesPager returns a Pager over the Pokemon species with limit
+// species per page. Offline, limit must be 0 or DefaultPageSize.
+func (c *Client) PokemonSpeciesPager(limit int) *Pager[NamedAPIResource] {
+	return NewPager[NamedAPIResource](c, "pokemon-species", limit)
+}
+
+// Next moves to the next page and returns it. Before any page was loaded,
+// that is the first page. On the last page, it returns ErrNoPage.
+func (p *Pager[T]) Next(ctx context.Context) (Page[T], error) {
+	if !p.started {
+		return p.load(ctx, 0)
+	}
+	if !p.HasNext() {
+		return Page[T]{}, ErrNoPage
+	}
+	return p.load(ctx, p.offset+p.limit)
+}
+
+// Prev moves to the previous page and returns it. On the first page, it
+// returns ErrNoPage.
+func (p *Pager[T]) Prev(ctx context.Context) (Page[T], error) {
+	if !p.HasPrev() {
+		return Page[T]{}, ErrNoPage
+	}
+	return p.load(ctx, max(p.offset-p.limit, 0))
+}
+
+// Page moves to page n, counting from 1, and returns it. If the list is
+// shorter than that, it returns ErrNoPage and stays on the current page.
+func (p *Pager[T]) Page(ctx context.Context, n int) (Page[T], error) {
+	offset := (n - 1) * p.limit
+	if n < 1 || (n > 1 && p.count >= 0 && offset >= p.count) {
+		return Page[T]{}, ErrNoPage
+	}
+	return p.load(ctx, offset)
+}
+
+// All returns the resources on every page of the list, loading the pages
+// one after another. It doesn't move the pager.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for offset := 0; ; offset += p.limit {
+		page, err := fetch[Page[T]](ctx, p.client, p.client.listURL(p.endpoint, offset, p.limit), p.ttl)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Results...)
+		if offset+p.limit >= page.Count || len(page.Results) == 0 {
+			return all, nil
+		}
+	}
+}
+
+// HasNext reports whether Next would return a page.
+func (p *Pager[T]) HasNext() bool {
+	return !p.started || p.count < 0 || p.offset+p.limit < p.count
+}
+
+// HasPrev reports whether Prev would return a page.
+func (p *Pager[T]) HasPrev() bool {
+	return p.started && p.offset > 0
+}
+
+// Current returns the number of the current page, counting from 1, or 0
+// before any page was loaded.
+func (p *Pager[T]) Current() int {
+	if !p.started {
+		return 0
+	}
+	return p.offset/p.limit + 1
+}
+
+// Pages returns the number of pages in the list, or 0 until a page was loaded.
+func (p *Pager[T]) Pages() int {
+	if p.count < 0 {
+		return 0
+	}
+	return (p.count + p.limit - 1) / p.limit
+}
+
+// NextURL returns the URL of the page Next would load, or nil if there is
+// none or no page was loaded yet. Together with PrevURL, it records the
+// position of the pager, e.g. in a save file, for Restore.
+func (p *Pager[T]) NextURL() *string {
+	if !p.started || !p.HasNext() {
+		return nil
+	}
+	url := p.client.listURL(p.endpoint, p.offset+p.limit, p.limit)
+	return &url
+}
+
+// PrevURL returns the URL of the page Prev would load, or nil if there is none.
+func (p *Pager[T]) PrevURL() *string {
+	if !p.HasPrev() {
+		return nil
+	}
+	url := p.client.listURL(p.endpoint, max(p.offset-p.limit, 0), p.limit)
+	return &url
+}
+
+// Restore moves the pager back to the position recorded by NextURL and
+// PrevURL, without loading the page. The offsets of the URLs are used, so
+// the page URLs PokeAPI returns work as well. Without either URL, the pager
+// starts over at the first page.
+func (p *Pager[T]) Restore(next, prev *string) {
+	p.started = false
+	p.offset = 0
+	if offset, ok := pageOffset(next); ok {
+		p.started = true
+		p.offset = max(offset-p.limit, 0)
+	} else if offset, ok := pageOffset(prev); ok {
+		p.started = true
+		p.offset = offset + p.limit
+	}
+}
+
+// load fetches the page starting at offset and makes it the current page.
+func (p *Pager[T]) load(ctx context.Context, offset int) (Page[T], error) {
+	url := p.client.listURL(p.endpoint, offset, p.limit)
+	page, err := fetch[Page[T]](ctx, p.client, url, p.ttl)
+	if err != nil {
+		return Page[T]{}, err
+	}
+	p.count = page.Count
+	if offset > 0 && offset >= page.Count {
+		return Page[T]{}, ErrNoPage
+	}
+	p.started = true
+	p.offset = offset
+
+	// Warm the cache with the page Next would load
+	var next *string
+	if p.offset+p.limit < p.count {
+		nextURL := p.client.listURL(p.endpoint, p.offset+p.limit, p.limit)
+		next = &nextURL
+	}
+	p.client.prefetchList(p.endpoint, next)
+	if p.loaded != nil {
+		p.loaded(page)
+	}
+	return page, nil
+}
+
+// listURL returns the URL of the page of endpoint starting at offset, in the
+// same form as the next and previous URLs PokeAPI returns, so both share
+// cache entries.
+func (c *Client) listURL(endpoint string, offset, limit int) string {
+	return fmt.Sprintf("%s/%s?offset=%d&limit=%d", c.baseURL, endpoint, offset, limit)
+}
+
+// pageOffset returns the offset query parameter of the page URL rawURL.
+func pageOffset(rawURL *string) (int, bool) {
+	if rawURL == nil {
+		return 0, false
+	}
+	u, err := url.Parse(*rawURL)
+	if err != nil {
+		return 0, false
+	}
+	offset, err := strconv.Atoi(u.Query().Get("offset"))
+	if err != nil || offset < 0 {
+		return 0, false
+	}
+	return offset, true
+}
@@ -0,0 +1,124 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/masteidel/pokedexcli/internal/pokecache"
+)
+
+// newListServer serves a list endpoint of count resources named "item-<i>",
+// honoring the offset and limit query parameters.
+func newListServer(t *testing.T, count int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		page := Page[NamedAPIResource]{Count: count}
+		for i := offset; i < min(offset+limit, count); i++ {
+			page.Results = append(page.Results, NamedAPIResource{Name: fmt.Sprintf("item-%d", i)})
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestPager(t *testing.T) {
+	server := newListServer(t, 25)
+	client := NewClient(WithBaseURL(server.URL), WithTimeout(time.Second), WithCache(pokecache.NewCache(time.Minute)), WithPrefetch(PrefetchPolicy{}))
+	defer client.Close()
+	ctx := context.Background()
+
+	pager := NewPager[NamedAPIResource](&client, "item", 10)
+	if pager.HasPrev() || !pager.HasNext() || pager.Current() != 0 {
+		t.Errorf("expected a fresh pager before the first page")
+	}
+
+	expectPage := func(page Page[NamedAPIResource], err error, current int, first string) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pager.Current() != current || page.Results[0].Name != first {
+			t.Errorf("expected page %d starting at %s, got page %d starting at %s", current, first, pager.Current(), page.Results[0].Name)
+		}
+	}
+
+	page, err := pager.Next(ctx)
+	expectPage(page, err, 1, "item-0")
+	if pager.Pages() != 3 {
+		t.Errorf("expected 3 pages, got %d", pager.Pages())
+	}
+	page, err = pager.Next(ctx)
+	expectPage(page, err, 2, "item-10")
+	page, err = pager.Next(ctx)
+	expectPage(page, err, 3, "item-20")
+	if pager.HasNext() {
+		t.Error("expected no page after the last one")
+	}
+	if _, err := pager.Next(ctx); !errors.Is(err, ErrNoPage) {
+		t.Errorf("expected ErrNoPage, got %v", err)
+	}
+
+	page, err = pager.Prev(ctx)
+	expectPage(page, err, 2, "item-10")
+	page, err = pager.Page(ctx, 1)
+	expectPage(page, err, 1, "item-0")
+	if _, err := pager.Prev(ctx); !errors.Is(err, ErrNoPage) {
+		t.Errorf("expected ErrNoPage, got %v", err)
+	}
+	if _, err := pager.Page(ctx, 4); !errors.Is(err, ErrNoPage) || pager.Current() != 1 {
+		t.Errorf("expected ErrNoPage without moving, got %v on page %d", err, pager.Current())
+	}
+
+	all, err := pager.All(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(all) != 25 || all[24].Name != "item-24" || pager.Current() != 1 {
+		t.Errorf("expected all 25 items without moving, got %d on page %d", len(all), pager.Current())
+	}
+}
+
+func TestPagerRestore(t *testing.T) {
+	server := newListServer(t, 25)
+	client := NewClient(WithBaseURL(server.URL), WithTimeout(time.Second), WithCache(nil), WithPrefetch(PrefetchPolicy{}))
+	defer client.Close()
+	ctx := context.Background()
+
+	pager := NewPager[NamedAPIResource](&client, "item", 10)
+	pager.Next(ctx)
+	pager.Next(ctx)
+	next, prev := pager.NextURL(), pager.PrevURL()
+	if next == nil || *next != server.URL+"/item?offset=20&limit=10" {
+		t.Errorf("unexpected next URL %v", next)
+	}
+
+	restored := NewPager[NamedAPIResource](&client, "item", 10)
+	restored.Restore(next, prev)
+	page, err := restored.Next(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.Results[0].Name != "item-20" {
+		t.Errorf("expected the page after the restored one, got %+v", page)
+	}
+
+	// At the last page only the previous URL is known
+	restored.Restore(nil, restored.PrevURL())
+	if page, err := restored.Prev(ctx); err != nil || page.Results[0].Name != "item-10" {
+		t.Errorf("expected the page before the last one, got %+v, %v", page, err)
+	}
+
+	restored.Restore(nil, nil)
+	if restored.Current() != 0 || restored.HasPrev() {
+		t.Errorf("expected the pager to start over")
+	}
+}
@@ -1,8 +1,24 @@
 // Package pokeapi is a wrapper for interacting with the PokeAPI
 package pokeapi
 
+import "time"
+
 // Constants that will be used across the package
 const (
-	// baseURL is the starting point for all API endpoints in the PokeAPI
-	baseURL = "https://pokeapi.co/api/v2"
+	// DefaultBaseURL is the starting point for all API endpoints in the public PokeAPI
+	DefaultBaseURL = "https://pokeapi.co/api/v2"
+)
+
+// How long responses of each endpoint stay fresh in the cache. Location data
+// practically never changes, while Pokemon data is refreshed more often.
+const (
+	locationListTTL   = 7 * 24 * time.Hour
+	locationTTL       = 7 * 24 * time.Hour
+	pokemonTTL        = 24 * time.Hour
+	speciesListTTL    = 24 * time.Hour
+	speciesTTL        = 24 * time.Hour
+	evolutionChainTTL = 7 * 24 * time.Hour
+	moveTTL           = 7 * 24 * time.Hour
+	typeTTL           = 7 * 24 * time.Hour
+	abilityTTL        = 7 * 24 * time.Hour
 )
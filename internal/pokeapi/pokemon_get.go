@@ -1,46 +1,15 @@
 package pokeapi
 
-import (
-	"encoding/json"
-	"io"
-	"net/http"
-)
+import "context"
 
+// GetPokemon retrieves a Pokemon by its name or national dex ID.
 func (c *Client) GetPokemon(pokemonName string) (Pokemon, error) {
-	url := baseURL + "/pokemon/" + pokemonName
-	if val, ok := c.cache.Get(url); ok {
-		pokemonResp := Pokemon{}
-		err := json.Unmarshal(val, &pokemonResp)
-		if err != nil {
-			return Pokemon{}, err
-		}
-		return pokemonResp, nil
-	}
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return Pokemon{}, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return Pokemon{}, err
-	}
-	defer resp.Body.Close()
-
-	dat, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return Pokemon{}, err
-	}
-
-	pokemonResp := Pokemon{}
-
-	err = json.Unmarshal(dat, &pokemonResp)
-	if err != nil {
-		return Pokemon{}, err
-	}
-
-	c.cache.Add(url, dat)
+	return c.GetPokemonContext(context.Background(), pokemonName)
+}
 
-	return pokemonResp, nil
+// GetPokemonContext is like GetPokemon, but the request is abandoned as soon
+// as ctx is cancelled.
+func (c *Client) GetPokemonContext(ctx context.Context, pokemonName string) (Pokemon, error) {
+	url := c.baseURL + "/pokemon/" + pokemonName
+	return fetch[Pokemon](ctx, c, url, pokemonTTL)
 }
@@ -0,0 +1,17 @@
+package pokeapi
+
+import "context"
+
+// GetPokemonSpecies retrieves a Pokemon species by its name or national dex ID.
+// Species names are the names of their default form, e.g. "deoxys" for the
+// "deoxys-normal" Pokemon.
+func (c *Client) GetPokemonSpecies(speciesName string) (PokemonSpecies, error) {
+	return c.GetPokemonSpeciesContext(context.Background(), speciesName)
+}
+
+// GetPokemonSpeciesContext is like GetPokemonSpecies, but the request is
+// abandoned as soon as ctx is cancelled.
+func (c *Client) GetPokemonSpeciesContext(ctx context.Context, speciesName string) (PokemonSpecies, error) {
+	url := c.baseURL + "/pokemon-species/" + speciesName
+	return fetch[PokemonSpecies](ctx, c, url, speciesTTL)
+}
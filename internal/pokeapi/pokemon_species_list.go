@@ -0,0 +1,19 @@
+package pokeapi
+
+import "context"
+
+// ListPokemonSpecies retrieves a page of the Pokemon species list.
+// pageURL selects a specific page; nil requests the first page.
+func (c *Client) ListPokemonSpecies(pageURL *string) (RespShallowPokemonSpecies, error) {
+	return c.ListPokemonSpeciesContext(context.Background(), pageURL)
+}
+
+// ListPokemonSpeciesContext is like ListPokemonSpecies, but the request is
+// abandoned as soon as ctx is cancelled.
+func (c *Client) ListPokemonSpeciesContext(ctx context.Context, pageURL *string) (RespShallowPokemonSpecies, error) {
+	url := c.listURL("pokemon-species", 0, DefaultPageSize)
+	if pageURL != nil {
+		url = *pageURL
+	}
+	return fetch[RespShallowPokemonSpecies](ctx, c, url, speciesListTTL)
+}
@@ -0,0 +1,64 @@
+package pokeapi
+
+import "encoding/json"
+
+// PrefetchPolicy controls what the client downloads into its cache in the
+// background after a page of locations is listed, so the next map or
+// explore command is served from the cache.
+type PrefetchPolicy struct {
+	NextPage  bool // NextPage prefetches the next page of the list
+	Locations bool // Locations prefetches the details of every listed location area
+}
+
+// DefaultPrefetchPolicy prefetches the next page of locations only.
+// Prefetching every listed area costs a request per area, so it is opt-in.
+var DefaultPrefetchPolicy = PrefetchPolicy{NextPage: true}
+
+// prefetchList downloads the page at next of the list endpoint in the
+// background, if the prefetch policy asks for the next page. Like every
+// prefetch, it is cancelled when the client is closed and failures are
+// ignored; the command that needs the data will simply download it again.
+func (c *Client) prefetchList(endpoint string, next *string) {
+	// Offline, everything is in the bundle already
+	if c.bundle != nil || !c.prefetch.NextPage || next == nil {
+		return
+	}
+
+	ttl, ok := listTTLs[endpoint]
+	if !ok {
+		ttl = defaultListTTL
+	}
+	url := *next
+
+	c.background.Add(1)
+	go func() {
+		defer c.background.Done()
+		fetch[Page[json.RawMessage]](c.backgroundCtx, c, url, ttl)
+	}()
+}
+
+// prefetchLocations downloads the details of the listed location areas in
+// the background, if the prefetch policy asks for them. The areas are
+// downloaded one after another, so they never take more than one request at
+// a time from the rate limiter.
+func (c *Client) prefetchLocations(areas []NamedAPIResource) {
+	if c.bundle != nil || !c.prefetch.Locations || len(areas) == 0 {
+		return
+	}
+
+	urls := make([]string, 0, len(areas))
+	for _, area := range areas {
+		urls = append(urls, c.baseURL+"/location-area/"+area.Name)
+	}
+
+	c.background.Add(1)
+	go func() {
+		defer c.background.Done()
+		for _, url := range urls {
+			if c.backgroundCtx.Err() != nil {
+				return
+			}
+			fetch[Location](c.backgroundCtx, c, url, locationTTL)
+		}
+	}()
+}
@@ -0,0 +1,172 @@
+package pokeapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/masteidel/pokedexcli/internal/pokecache"
+)
+
+func TestPrefetch(t *testing.T) {
+	var mux sync.Mutex
+	requests := map[string]int{}
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		requests[r.URL.String()]++
+		mux.Unlock()
+		switch r.URL.String() {
+		case "/location-area?offset=0&limit=20":
+			w.Write([]byte(`{"count": 2, "next": "` + server.URL + `/location-area?offset=1", "results": [{"name": "canalave-city-area"}]}`))
+		case "/location-area?offset=1":
+			w.Write([]byte(`{"count": 2, "next": null, "results": [{"name": "eterna-city-area"}]}`))
+		default:
+			w.Write([]byte(`{"name": "area"}`))
+		}
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		name     string
+		policy   PrefetchPolicy
+		expected map[string]int
+	}{
+		{
+			name:     "disabled",
+			policy:   PrefetchPolicy{},
+			expected: map[string]int{"/location-area?offset=0&limit=20": 1},
+		},
+		{
+			name:     "next page",
+			policy:   DefaultPrefetchPolicy,
+			expected: map[string]int{"/location-area?offset=0&limit=20": 1, "/location-area?offset=1": 1},
+		},
+		{
+			name:   "locations",
+			policy: PrefetchPolicy{NextPage: true, Locations: true},
+			expected: map[string]int{
+				"/location-area?offset=0&limit=20":  1,
+				"/location-area?offset=1":           1,
+				"/location-area/canalave-city-area": 1,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mux.Lock()
+			clear(requests)
+			mux.Unlock()
+
+			client := NewClient(WithBaseURL(server.URL), WithTimeout(time.Second), WithCache(pokecache.NewCache(time.Minute)), WithPrefetch(c.policy))
+			defer client.Close()
+
+			if _, err := client.ListLocations(nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			client.background.Wait()
+
+			mux.Lock()
+			defer mux.Unlock()
+			if len(requests) != len(c.expected) {
+				t.Errorf("expected requests %v, got %v", c.expected, requests)
+			}
+			for url, n := range c.expected {
+				if requests[url] != n {
+					t.Errorf("expected %d requests for %s, got %d", n, url, requests[url])
+				}
+			}
+		})
+	}
+}
+
+func TestPrefetchServesNextPage(t *testing.T) {
+	requests := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"count": 2, "next": "` + server.URL + `/location-area?offset=1", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithTimeout(time.Second), WithCache(pokecache.NewCache(time.Minute)), WithPrefetch(PrefetchPolicy{NextPage: true}))
+	defer client.Close()
+
+	page, err := client.ListLocations(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.background.Wait()
+	if _, err := client.ListLocations(page.Next); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.background.Wait()
+
+	// The second page was prefetched, so listing it made no request
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestCloseCancelsPrefetch(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "0" {
+			// The prefetched page never arrives
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte(`{"count": 2, "next": "` + server.URL + `/location-area?offset=1", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithTimeout(time.Minute), WithCache(nil))
+	if _, err := client.ListLocations(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		client.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close didn't cancel the running prefetch")
+	}
+}
+
+func TestLocationAreaPagerPrefetchesLocations(t *testing.T) {
+	var mux sync.Mutex
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		requests[r.URL.Path]++
+		mux.Unlock()
+		if r.URL.Path == "/location-area" {
+			w.Write([]byte(`{"count": 1, "next": null, "results": [{"name": "canalave-city-area"}]}`))
+			return
+		}
+		w.Write([]byte(`{"name": "area"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithTimeout(time.Second), WithCache(pokecache.NewCache(time.Minute)), WithPrefetch(PrefetchPolicy{Locations: true}))
+	defer client.Close()
+
+	if _, err := client.LocationAreaPager(0).Next(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.background.Wait()
+
+	mux.Lock()
+	defer mux.Unlock()
+	if requests["/location-area/canalave-city-area"] != 1 {
+		t.Errorf("expected the listed area to be prefetched, got %v", requests)
+	}
+}
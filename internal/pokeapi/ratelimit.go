@@ -0,0 +1,72 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket shared by every request of a Client, so all
+// endpoints together stay under the configured request rate.
+type rateLimiter struct {
+	mux    sync.Mutex
+	rate   float64   // rate is the number of tokens added per second
+	burst  float64   // burst is the capacity of the bucket
+	tokens float64   // tokens is the number of tokens available at last
+	last   time.Time // last is when tokens was last updated
+}
+
+// newRateLimiter creates a rateLimiter allowing perSecond requests per second
+// on average and up to burst requests at once. A perSecond of 0 or less
+// disables rate limiting and returns nil.
+func newRateLimiter(perSecond float64, burst int) *rateLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may be made or ctx is cancelled.
+// A nil rateLimiter never blocks.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	l.mux.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	// Take a token now, going into debt if there is none; the debt is
+	// paid back by waiting until it would have been refilled.
+	l.tokens--
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mux.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+	if err := sleep(ctx, wait); err != nil {
+		// The request won't be made, give the token back
+		l.mux.Lock()
+		l.tokens++
+		l.mux.Unlock()
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how failed GET requests are retried. Only failures
+// that may succeed later are retried: network errors, 429 Too Many Requests
+// and 5xx server errors.
+type RetryPolicy struct {
+	MaxRetries    int           // MaxRetries is the number of retries after the first attempt, 0 disables retries
+	BaseDelay     time.Duration // BaseDelay is the backoff before the first retry, doubled for every further retry
+	MaxDelay      time.Duration // MaxDelay caps the backoff between two attempts
+	MaxRetryAfter time.Duration // MaxRetryAfter is the longest Retry-After the client is willing to wait for
+}
+
+// DefaultRetryPolicy is the RetryPolicy used by NewClient.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries:    3,
+	BaseDelay:     200 * time.Millisecond,
+	MaxDelay:      5 * time.Second,
+	MaxRetryAfter: 30 * time.Second,
+}
+
+// transient reports whether err, from a request that got no complete
+// response, may go away if the request is retried: network failures,
+// timeouts and connections dropped halfway. Errors such as an invalid URL
+// or an unsupported protocol would only fail again.
+func transient(err error) bool {
+	// The http client wraps every error in a *url.Error, which is a net.Error
+	// itself, so look at the cause
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		if urlErr.Timeout() {
+			return true
+		}
+		err = urlErr.Err
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
+
+// retryable reports whether a response with the given status may succeed if retried.
+func retryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// backoff returns how long to wait before the given retry (starting at 1).
+// The delay grows exponentially and is jittered, so clients that failed
+// together don't retry together: it is picked at random from the upper
+// half of the exponential delay.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.MaxDelay
+	if shift := retry - 1; shift < 32 && p.BaseDelay<<shift < p.MaxDelay {
+		delay = p.BaseDelay << shift
+	}
+	if delay <= 1 {
+		return delay
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)))
+}
+
+// parseRetryAfter reads the Retry-After header of resp, given either in
+// seconds or as an HTTP date. It returns 0 if the header is missing or invalid.
+func parseRetryAfter(resp *http.Response, now time.Time) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+// sleepFunc waits for d, returning early with the context's error if ctx is cancelled.
+type sleepFunc func(ctx context.Context, d time.Duration) error
+
+// sleep waits for d, returning early with the context's error if ctx is cancelled.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
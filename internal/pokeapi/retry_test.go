@@ -0,0 +1,143 @@
+package pokeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fastRetries retries quickly so tests don't wait on real backoff delays.
+var fastRetries = RetryPolicy{
+	MaxRetries:    2,
+	BaseDelay:     time.Millisecond,
+	MaxDelay:      time.Millisecond,
+	MaxRetryAfter: time.Second,
+}
+
+func TestRetries(t *testing.T) {
+	cases := []struct {
+		name             string
+		failures         int
+		status           int
+		drop             bool   // drop closes the connection of failed requests instead of answering
+		scheme           string // scheme replaces the scheme of the server URL
+		retryAfter       string
+		hasError         bool
+		expectedErr      error
+		expectedRequests int
+		expectedRetries  int
+		expectedDelay    time.Duration // expectedDelay is the least time waited before retrying
+	}{
+		{name: "recovers from server errors", failures: 2, status: http.StatusServiceUnavailable, expectedRequests: 3, expectedRetries: 2},
+		{name: "gives up after max retries", failures: 3, status: http.StatusBadGateway, expectedErr: ErrServer, expectedRequests: 3, expectedRetries: 2},
+		{name: "honors retry after", failures: 1, status: http.StatusTooManyRequests, retryAfter: "1", expectedRequests: 2, expectedRetries: 1, expectedDelay: time.Second},
+		{name: "retry after too long", failures: 1, status: http.StatusTooManyRequests, retryAfter: "3600", expectedErr: ErrRateLimited, expectedRequests: 1},
+		{name: "not found is not retried", failures: 1, status: http.StatusNotFound, expectedErr: ErrNotFound, expectedRequests: 1},
+		{name: "recovers from dropped connections", failures: 1, drop: true, expectedRequests: 2, expectedRetries: 1},
+		{name: "unsupported protocol is not retried", scheme: "ftp", hasError: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests <= c.failures && c.drop {
+					conn, _, _ := w.(http.Hijacker).Hijack()
+					conn.Close()
+					return
+				}
+				if requests <= c.failures {
+					if c.retryAfter != "" {
+						w.Header().Set("Retry-After", c.retryAfter)
+					}
+					http.Error(w, http.StatusText(c.status), c.status)
+					return
+				}
+				w.Write([]byte(`{"count": 0}`))
+			}))
+			defer server.Close()
+
+			client := NewClient(WithTimeout(time.Second), WithCache(nil), WithRetryPolicy(fastRetries))
+			defer client.Close()
+			// Record the delays instead of sleeping through them
+			retries := 0
+			var delay time.Duration
+			client.retrySleep = func(ctx context.Context, d time.Duration) error {
+				retries++
+				delay += d
+				return ctx.Err()
+			}
+
+			url := server.URL
+			if c.scheme != "" {
+				url = c.scheme + strings.TrimPrefix(url, "http")
+			}
+			_, err := client.ListLocations(&url)
+			if c.expectedErr == nil && !c.hasError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if c.hasError && err == nil {
+				t.Error("expected an error")
+			}
+			if c.expectedErr != nil && !errors.Is(err, c.expectedErr) {
+				t.Errorf("expected %v, got %v", c.expectedErr, err)
+			}
+			if requests != c.expectedRequests {
+				t.Errorf("expected %d requests, got %d", c.expectedRequests, requests)
+			}
+			if retries != c.expectedRetries {
+				t.Errorf("expected %d retries, got %d", c.expectedRetries, retries)
+			}
+			if delay < c.expectedDelay {
+				t.Errorf("expected to wait at least %v, waited %v", c.expectedDelay, delay)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	cases := []struct {
+		retry int
+		limit time.Duration
+	}{
+		{retry: 1, limit: 100 * time.Millisecond},
+		{retry: 2, limit: 200 * time.Millisecond},
+		{retry: 3, limit: 400 * time.Millisecond},
+		{retry: 10, limit: time.Second},
+		{retry: 100, limit: time.Second},
+	}
+	for _, c := range cases {
+		for i := 0; i < 20; i++ {
+			if d := policy.backoff(c.retry); d < c.limit/2 || d > c.limit {
+				t.Errorf("backoff(%d) == %v, expected between %v and %v", c.retry, d, c.limit/2, c.limit)
+			}
+		}
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(100, 1)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// The first request uses the burst, the next three wait 10ms each
+	if elapsed := time.Since(start); elapsed < 25*time.Millisecond {
+		t.Errorf("expected requests to be spread out, took %v", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+)
+
+// flightGroup coalesces concurrent downloads of the same URL: the first
+// caller starts the download and later callers wait for its result instead
+// of sending their own request.
+type flightGroup struct {
+	mux     sync.Mutex
+	flights map[string]*flight // flights holds the downloads in progress, keyed by URL
+}
+
+// flight is a download in progress shared by one or more callers.
+type flight struct {
+	done    chan struct{}      // done is closed once val and err are set
+	val     []byte             // val is the result of the download
+	err     error              // err is the error of the download
+	waiters int                // waiters is the number of callers still waiting
+	cancel  context.CancelFunc // cancel abandons the download
+}
+
+// newFlightGroup creates an empty flightGroup.
+func newFlightGroup() *flightGroup {
+	return &flightGroup{flights: map[string]*flight{}}
+}
+
+// do runs fn for key unless a call for the same key is already in progress,
+// in which case it waits for that call's result. Every caller receives the
+// same result.
+//
+// fn runs with its own context, so a caller that gives up because its ctx
+// was cancelled doesn't fail the others; fn is only cancelled once all of
+// its callers have given up.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	g.mux.Lock()
+	f, ok := g.flights[key]
+	if !ok {
+		// The shared call keeps the values of the first caller's context,
+		// but not its cancellation.
+		flightCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.flights[key] = f
+
+		go func() {
+			f.val, f.err = fn(flightCtx)
+			g.forget(key, f)
+			cancel()
+			close(f.done)
+		}()
+	}
+	f.waiters++
+	g.mux.Unlock()
+
+	select {
+	case <-f.done:
+		return f.val, f.err
+	case <-ctx.Done():
+		g.mux.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			// Nobody is interested in the result anymore; new callers
+			// must start a fresh call rather than join a cancelled one.
+			f.cancel()
+			if g.flights[key] == f {
+				delete(g.flights, key)
+			}
+		}
+		g.mux.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// forget removes f from the group, unless it was already replaced.
+func (g *flightGroup) forget(key string, f *flight) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+}
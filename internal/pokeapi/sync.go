@@ -0,0 +1,185 @@
+package pokeapi
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// syncResources are the endpoints Sync downloads, in order. Every resource
+// on each of their list pages is downloaded by name, or by ID for resources
+// without a name like evolution chains.
+var syncResources = []string{"location-area", "pokemon", "pokemon-species", "evolution-chain", "type", "move", "ability"}
+
+// syncWorkers is how many resources Sync downloads at once. The rate limiter
+// still applies, this only hides the latency of each request.
+const syncWorkers = 4
+
+// SyncProgress reports how far Sync has come with one kind of resource.
+type SyncProgress struct {
+	Resource string // Resource is the endpoint being downloaded, e.g. "pokemon"
+	Done     int    // Done is the number of resources downloaded so far
+	Total    int    // Total is the number of resources of this kind
+}
+
+// Sync downloads every resource the Pokedex uses into a bundle file at path,
+// for use with WithBundle. progress, if not nil, is called after every
+// downloaded resource.
+// The bundle is written to a temporary file and renamed into place once it
+// is complete, so a cancelled or failed sync keeps the previous bundle.
+// Sync bypasses the cache, but requests are rate limited and retried as usual.
+func (c *Client) Sync(ctx context.Context, path string, progress func(SyncProgress)) (BundleManifest, error) {
+	if c.bundle != nil {
+		return BundleManifest{}, errors.New("can't sync in offline mode")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return BundleManifest{}, err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return BundleManifest{}, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	w := &bundleWriter{zip: zip.NewWriter(tmp)}
+	for _, resource := range syncResources {
+		if err := c.syncResource(ctx, w, resource, progress); err != nil {
+			return BundleManifest{}, err
+		}
+	}
+
+	manifest := BundleManifest{
+		Version:  bundleVersion,
+		BaseURL:  c.baseURL,
+		SyncedAt: time.Now().UTC(),
+		Entries:  w.entries,
+	}
+	dat, err := json.Marshal(manifest)
+	if err != nil {
+		return BundleManifest{}, err
+	}
+	if err := w.add(bundleManifestName, dat); err != nil {
+		return BundleManifest{}, err
+	}
+	if err := w.zip.Close(); err != nil {
+		return BundleManifest{}, err
+	}
+	if err := tmp.Close(); err != nil {
+		return BundleManifest{}, err
+	}
+	return manifest, os.Rename(tmp.Name(), path)
+}
+
+// syncResource downloads every list page of resource and then every resource
+// listed on them into w.
+func (c *Client) syncResource(ctx context.Context, w *bundleWriter, resource string, progress func(SyncProgress)) error {
+	// Walk the list pages the same way a Pager does, so they are all in the bundle
+	var names []string
+	for offset := 0; ; offset += DefaultPageSize {
+		url := c.listURL(resource, offset, DefaultPageSize)
+		dat, err := c.syncGet(ctx, url)
+		if err != nil {
+			return err
+		}
+		if err := w.add(c.bundleKey(url), dat); err != nil {
+			return err
+		}
+
+		var page Page[NamedAPIResource]
+		if err := json.Unmarshal(dat, &page); err != nil {
+			return fmt.Errorf("decoding %s: %w", url, err)
+		}
+		for _, result := range page.Results {
+			if result.Name != "" {
+				names = append(names, result.Name)
+			} else if id, ok := (APIResource{URL: result.URL}).ID(); ok {
+				names = append(names, strconv.Itoa(id))
+			}
+		}
+		if offset+DefaultPageSize >= page.Count || len(page.Results) == 0 {
+			break
+		}
+	}
+
+	// Download the listed resources in parallel, stopping at the first error
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var errOnce sync.Once
+	var firstErr error
+	var mux sync.Mutex // mux serializes progress reports
+	done := 0
+
+	forEach(ctx, syncWorkers, len(names), func(i int) {
+		url := c.baseURL + "/" + resource + "/" + names[i]
+		dat, err := c.syncGet(ctx, url)
+		if err == nil {
+			err = w.add(c.bundleKey(url), dat)
+		}
+		if err != nil {
+			errOnce.Do(func() {
+				firstErr = err
+				cancel()
+			})
+			return
+		}
+
+		mux.Lock()
+		done++
+		if progress != nil {
+			progress(SyncProgress{Resource: resource, Done: done, Total: len(names)})
+		}
+		mux.Unlock()
+	})
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// syncGet downloads the response for url, making sure it is valid JSON.
+func (c *Client) syncGet(ctx context.Context, url string) ([]byte, error) {
+	entry, err := c.download(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(entry.Value) {
+		return nil, fmt.Errorf("GET %s: invalid JSON response", url)
+	}
+	return entry.Value, nil
+}
+
+// bundleWriter adds responses to a bundle archive. It is safe for concurrent use.
+type bundleWriter struct {
+	mux     sync.Mutex  // mux guards the fields below
+	zip     *zip.Writer // zip is the archive being written
+	entries int         // entries counts the responses added so far
+}
+
+// add stores dat in the archive under key.
+func (w *bundleWriter) add(key string, dat []byte) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	f, err := w.zip.Create(key)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(dat); err != nil {
+		return err
+	}
+	if key != bundleManifestName {
+		w.entries++
+	}
+	return nil
+}
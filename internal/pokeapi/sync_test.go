@@ -0,0 +1,171 @@
+package pokeapi
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newSyncServer serves a tiny PokeAPI with two pages of location areas and a
+// single resource on every other endpoint Sync walks. The evolution chain is
+// listed by URL only, like on PokeAPI.
+func newSyncServer(t *testing.T) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/location-area":
+			// 21 areas, so the list spans two pages
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			page := Page[NamedAPIResource]{Count: 21}
+			for i := offset; i < min(offset+DefaultPageSize, 21); i++ {
+				page.Results = append(page.Results, NamedAPIResource{Name: fmt.Sprintf("area-%d", i)})
+			}
+			if offset == 0 {
+				next := server.URL + "/location-area?offset=20&limit=20"
+				page.Next = &next
+			}
+			json.NewEncoder(w).Encode(page)
+		case r.URL.Path == "/evolution-chain":
+			w.Write([]byte(`{"count": 1, "next": null, "results": [{"url": "` + server.URL + `/evolution-chain/10/"}]}`))
+		case strings.Count(r.URL.Path, "/") == 1:
+			w.Write([]byte(`{"count": 1, "next": null, "results": [{"name": "pikachu"}]}`))
+		case strings.HasPrefix(r.URL.Path, "/location-area/"):
+			w.Write([]byte(`{"name": "` + strings.TrimPrefix(r.URL.Path, "/location-area/") + `"}`))
+		case r.URL.Path == "/pokemon/pikachu":
+			w.Write([]byte(`{"name": "pikachu", "base_experience": 112}`))
+		default:
+			w.Write([]byte(`{"name": "pikachu"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSyncAndOffline(t *testing.T) {
+	server := newSyncServer(t)
+	path := filepath.Join(t.TempDir(), "bundle.zip")
+
+	online := NewClient(WithBaseURL(server.URL), WithTimeout(time.Second), WithRateLimit(0, 0), WithCache(nil))
+	progressed := 0
+	manifest, err := online.Sync(context.Background(), path, func(SyncProgress) { progressed++ })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 2 location area pages and 21 areas, a page and a resource for each of the other 6 endpoints
+	if manifest.Entries != 35 {
+		t.Errorf("expected 35 entries, got %d", manifest.Entries)
+	}
+	if progressed != 27 {
+		t.Errorf("expected 27 progress reports, got %d", progressed)
+	}
+
+	bundle, err := OpenBundle(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The server is gone, so everything has to come from the bundle
+	server.Close()
+	offline := NewClient(WithBaseURL(DefaultBaseURL), WithBundle(bundle), WithCache(nil))
+	defer offline.Close()
+
+	page, err := offline.ListLocations(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	page, err = offline.ListLocations(page.Next)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.Results[0].Name != "area-20" {
+		t.Errorf("expected the second page, got %+v", page)
+	}
+
+	areas, err := offline.LocationAreaPager(0).All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(areas) != 21 {
+		t.Errorf("expected 21 areas, got %d", len(areas))
+	}
+
+	if _, err := offline.GetEvolutionChain(10); err != nil {
+		t.Errorf("expected the evolution chain to be synced by ID, got %v", err)
+	}
+
+	pokemon, err := offline.GetPokemon("pikachu")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pokemon.BaseExperience != 112 {
+		t.Errorf("unexpected pokemon: %+v", pokemon)
+	}
+
+	_, err = offline.GetPokemon("missingno")
+	var offlineErr *OfflineError
+	if !errors.Is(err, ErrOffline) || !errors.As(err, &offlineErr) || offlineErr.Key != "pokemon/missingno" {
+		t.Errorf("expected an OfflineError for pokemon/missingno, got %v", err)
+	}
+
+	if _, err := offline.Sync(context.Background(), path, nil); err == nil {
+		t.Error("expected Sync to fail in offline mode")
+	}
+}
+
+func TestOpenBundleRejectsOldVersion(t *testing.T) {
+	// Version 1 bundles stored the first list page without offset and limit
+	path := filepath.Join(t.TempDir(), "bundle.zip")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(f)
+	manifest, _ := zw.Create(bundleManifestName)
+	manifest.Write([]byte(`{"version": 1, "base_url": "https://pokeapi.co/api/v2"}`))
+	page, _ := zw.Create("location-area")
+	page.Write([]byte(`{"count": 0, "results": []}`))
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	_, err = OpenBundle(path)
+	if err == nil || !strings.Contains(err.Error(), "unsupported bundle version 1") {
+		t.Errorf("expected an unsupported bundle version error, got %v", err)
+	}
+}
+
+func TestSyncCancelledKeepsBundle(t *testing.T) {
+	server := newSyncServer(t)
+	path := filepath.Join(t.TempDir(), "bundle.zip")
+
+	client := NewClient(WithBaseURL(server.URL), WithTimeout(time.Second), WithRateLimit(0, 0), WithCache(nil))
+	if _, err := client.Sync(context.Background(), path, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.Sync(ctx, path, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+
+	bundle, err := OpenBundle(path)
+	if err != nil {
+		t.Fatalf("expected the previous bundle to survive, got %v", err)
+	}
+	bundle.Close()
+	matches, _ := filepath.Glob(path + ".*.tmp")
+	if len(matches) != 0 {
+		t.Errorf("expected no temporary files, got %v", matches)
+	}
+}
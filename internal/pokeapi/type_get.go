@@ -0,0 +1,15 @@
+package pokeapi
+
+import "context"
+
+// GetType retrieves a type by its name or ID.
+func (c *Client) GetType(typeName string) (Type, error) {
+	return c.GetTypeContext(context.Background(), typeName)
+}
+
+// GetTypeContext is like GetType, but the request is abandoned as soon as
+// ctx is cancelled.
+func (c *Client) GetTypeContext(ctx context.Context, typeName string) (Type, error) {
+	url := c.baseURL + "/type/" + typeName
+	return fetch[Type](ctx, c, url, typeTTL)
+}
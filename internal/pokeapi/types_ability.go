@@ -0,0 +1,37 @@
+package pokeapi
+
+// Ability is a passive effect Pokemon have in battle or in the overworld.
+type Ability struct {
+	ID   int    `json:"id"`   // ID identifies the ability
+	Name string `json:"name"` // Name is the ability name, e.g. "levitate"
+
+	// IsMainSeries is false for abilities that only exist in spin-off games.
+	IsMainSeries bool `json:"is_main_series"`
+
+	Generation    NamedAPIResource `json:"generation"`     // Generation introduced the ability
+	EffectEntries []VerboseEffect  `json:"effect_entries"` // EffectEntries describe the effect in several languages
+	Names         []Name           `json:"names"`          // Names are the localized names of the ability
+	Pokemon       []AbilityPokemon `json:"pokemon"`        // Pokemon are the Pokemon that can have the ability
+}
+
+// AbilityPokemon is a Pokemon that can have an ability.
+type AbilityPokemon struct {
+	// IsHidden is set when the Pokemon only has the ability as its hidden
+	// ability, which is rarer than its regular ones.
+	IsHidden bool `json:"is_hidden"`
+
+	Slot    int              `json:"slot"`    // Slot is the ability slot of the Pokemon, 3 for hidden abilities
+	Pokemon NamedAPIResource `json:"pokemon"` // Pokemon is the Pokemon
+}
+
+// ShortEffect returns the one sentence description of the ability's effect
+// in language, or "" if there is none.
+func (a Ability) ShortEffect(language string) string {
+	return findEffect(a.EffectEntries, language).ShortEffect
+}
+
+// Effect returns the full description of the ability's effect in language,
+// or "" if there is none.
+func (a Ability) Effect(language string) string {
+	return findEffect(a.EffectEntries, language).Effect
+}
@@ -0,0 +1,60 @@
+package pokeapi
+
+// EvolutionChain is the evolution family of a group of species, starting at
+// its base form.
+type EvolutionChain struct {
+	ID int `json:"id"` // ID identifies the chain; chains have no name
+
+	// BabyTriggerItem is the item a parent must hold to breed the baby form
+	// of the chain, nil if there is none.
+	BabyTriggerItem *NamedAPIResource `json:"baby_trigger_item"`
+
+	Chain ChainLink `json:"chain"` // Chain is the base form of the family
+}
+
+// ChainLink is a species in an evolution chain, with the species it evolves into.
+type ChainLink struct {
+	IsBaby  bool             `json:"is_baby"` // IsBaby is set for baby forms, which only hatch from eggs
+	Species NamedAPIResource `json:"species"` // Species is the species at this link
+
+	// EvolutionDetails are the ways the previous link evolves into this one.
+	// It is empty for the base form.
+	EvolutionDetails []EvolutionDetail `json:"evolution_details"`
+
+	// EvolvesTo are the links this species evolves into. Branching families
+	// like Eevee's have several, fully evolved species none.
+	EvolvesTo []ChainLink `json:"evolves_to"`
+}
+
+// EvolutionDetail is one way of evolving into a species. Besides the
+// trigger, only the conditions that apply are set.
+type EvolutionDetail struct {
+	// Trigger is what starts the evolution, e.g. "level-up", "trade" or "use-item".
+	Trigger NamedAPIResource `json:"trigger"`
+
+	Item         *NamedAPIResource `json:"item"`          // Item is the item to use on the Pokemon
+	HeldItem     *NamedAPIResource `json:"held_item"`     // HeldItem is the item the Pokemon must hold
+	TradeSpecies *NamedAPIResource `json:"trade_species"` // TradeSpecies is the species to trade with
+
+	MinLevel     *int `json:"min_level"`     // MinLevel is the level to reach
+	MinHappiness *int `json:"min_happiness"` // MinHappiness is the happiness needed, from 0 to 255
+	MinAffection *int `json:"min_affection"` // MinAffection is the affection needed, in hearts
+	MinBeauty    *int `json:"min_beauty"`    // MinBeauty is the beauty needed, from 0 to 255
+
+	// TimeOfDay is "day" or "night" when the evolution depends on it, empty otherwise.
+	TimeOfDay string `json:"time_of_day"`
+
+	Gender        *int              `json:"gender"`          // Gender is 1 for female and 2 for male
+	KnownMove     *NamedAPIResource `json:"known_move"`      // KnownMove is a move the Pokemon must know
+	KnownMoveType *NamedAPIResource `json:"known_move_type"` // KnownMoveType is the type of a move the Pokemon must know
+	Location      *NamedAPIResource `json:"location"`        // Location is where the Pokemon must level up
+	PartySpecies  *NamedAPIResource `json:"party_species"`   // PartySpecies must be in the party
+	PartyType     *NamedAPIResource `json:"party_type"`      // PartyType is a type a party member must have
+
+	// RelativePhysicalStats compares Attack to Defense: 1 means greater,
+	// -1 less and 0 equal. It is nil when the evolution doesn't depend on it.
+	RelativePhysicalStats *int `json:"relative_physical_stats"`
+
+	NeedsOverworldRain bool `json:"needs_overworld_rain"` // NeedsOverworldRain requires rain where the Pokemon levels up
+	TurnUpsideDown     bool `json:"turn_upside_down"`     // TurnUpsideDown requires holding the console upside down
+}
@@ -0,0 +1,87 @@
+package pokeapi
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Move is a move Pokemon can use in battle.
+type Move struct {
+	ID   int    `json:"id"`   // ID identifies the move
+	Name string `json:"name"` // Name is the move name, e.g. "thunderbolt"
+
+	// Power is the base power of the move, nil for moves that don't deal
+	// damage directly.
+	Power *int `json:"power"`
+
+	// Accuracy is the percent chance of hitting, nil for moves that never miss.
+	Accuracy *int `json:"accuracy"`
+
+	// PP is how many times the move can be used before resting, nil for
+	// moves like Struggle that have no limit.
+	PP *int `json:"pp"`
+
+	// Priority decides the order of moves in a turn, from -8 to +5; higher
+	// goes first regardless of speed.
+	Priority int `json:"priority"`
+
+	// EffectChance is the percent chance of the move's secondary effect,
+	// nil for moves whose effect always happens.
+	EffectChance *int `json:"effect_chance"`
+
+	// DamageClass is "physical", "special" or "status".
+	DamageClass NamedAPIResource `json:"damage_class"`
+
+	Type       NamedAPIResource `json:"type"`       // Type is the elemental type of the move
+	Target     NamedAPIResource `json:"target"`     // Target is who the move affects, e.g. "selected-pokemon"
+	Generation NamedAPIResource `json:"generation"` // Generation introduced the move
+
+	EffectEntries []VerboseEffect  `json:"effect_entries"` // EffectEntries describe the effect in several languages
+	StatChanges   []MoveStatChange `json:"stat_changes"`   // StatChanges are the stages the move raises or lowers
+	Names         []Name           `json:"names"`          // Names are the localized names of the move
+}
+
+// VerboseEffect is the localized description of an effect, in full and short.
+type VerboseEffect struct {
+	Effect      string           `json:"effect"`       // Effect is the full description
+	ShortEffect string           `json:"short_effect"` // ShortEffect is a one sentence summary
+	Language    NamedAPIResource `json:"language"`     // Language of the description
+}
+
+// MoveStatChange is a stat the move raises or lowers.
+type MoveStatChange struct {
+	Change int              `json:"change"` // Change is the number of stages, negative when lowered
+	Stat   NamedAPIResource `json:"stat"`   // Stat is the stat changed
+}
+
+// ShortEffect returns the one sentence description of the move's effect in
+// language, or "" if there is none.
+func (m Move) ShortEffect(language string) string {
+	return m.fillEffectChance(findEffect(m.EffectEntries, language).ShortEffect)
+}
+
+// Effect returns the full description of the move's effect in language,
+// or "" if there is none.
+func (m Move) Effect(language string) string {
+	return m.fillEffectChance(findEffect(m.EffectEntries, language).Effect)
+}
+
+// fillEffectChance replaces the $effect_chance placeholder PokeAPI uses in
+// effect texts with the move's EffectChance.
+func (m Move) fillEffectChance(text string) string {
+	if m.EffectChance == nil {
+		return text
+	}
+	return strings.ReplaceAll(text, "$effect_chance", strconv.Itoa(*m.EffectChance))
+}
+
+// findEffect returns the entry of entries in language, or an empty
+// VerboseEffect if there is none.
+func findEffect(entries []VerboseEffect, language string) VerboseEffect {
+	for _, entry := range entries {
+		if entry.Language.Name == language {
+			return entry
+		}
+	}
+	return VerboseEffect{}
+}
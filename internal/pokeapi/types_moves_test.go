@@ -0,0 +1,41 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMoveEffect(t *testing.T) {
+	dat := []byte(`{
+		"name": "thunderbolt",
+		"power": 90,
+		"accuracy": 100,
+		"pp": 15,
+		"effect_chance": 10,
+		"damage_class": {"name": "special"},
+		"type": {"name": "electric"},
+		"effect_entries": [{
+			"effect": "Inflicts regular damage. Has a $effect_chance% chance to paralyze the target.",
+			"short_effect": "Has a $effect_chance% chance to paralyze the target.",
+			"language": {"name": "en"}
+		}],
+		"stat_changes": []
+	}`)
+	var move Move
+	if err := json.Unmarshal(dat, &move); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if effect := move.ShortEffect("en"); effect != "Has a 10% chance to paralyze the target." {
+		t.Errorf("unexpected short effect %q", effect)
+	}
+	if effect := move.Effect("en"); effect != "Inflicts regular damage. Has a 10% chance to paralyze the target." {
+		t.Errorf("unexpected effect %q", effect)
+	}
+	if effect := move.ShortEffect("de"); effect != "" {
+		t.Errorf("expected no german effect, got %q", effect)
+	}
+	if move.Power == nil || *move.Power != 90 || move.DamageClass.Name != "special" {
+		t.Errorf("unexpected move %+v", move)
+	}
+}
@@ -0,0 +1,125 @@
+package pokeapi
+
+import (
+	"strconv"
+	"strings"
+)
+
+// RespShallowPokemonSpecies is a page of the Pokemon species list.
+type RespShallowPokemonSpecies = Page[NamedAPIResource]
+
+// PokemonSpecies is the data shared by every form of a Pokemon, such as its
+// Pokedex entries and its place in an evolution chain.
+type PokemonSpecies struct {
+	ID    int    `json:"id"`    // ID is the national dex number
+	Name  string `json:"name"`  // Name is the species name, e.g. "bulbasaur"
+	Order int    `json:"order"` // Order sorts species so evolution families are grouped together
+
+	// CaptureRate is the base chance of catching the species, from 0 to 255;
+	// higher is easier.
+	CaptureRate int `json:"capture_rate"`
+
+	// BaseHappiness is the happiness of a newly caught Pokemon of the species,
+	// from 0 to 255. It is nil for species that don't have one.
+	BaseHappiness *int `json:"base_happiness"`
+
+	// GenderRate is the chance of being female in eighths, or -1 for
+	// genderless species.
+	GenderRate int `json:"gender_rate"`
+
+	IsBaby      bool `json:"is_baby"`      // IsBaby is set for baby Pokemon, e.g. "pichu"
+	IsLegendary bool `json:"is_legendary"` // IsLegendary is set for legendary Pokemon
+	IsMythical  bool `json:"is_mythical"`  // IsMythical is set for mythical Pokemon
+
+	GrowthRate NamedAPIResource `json:"growth_rate"` // GrowthRate is how fast the species gains levels
+	Generation NamedAPIResource `json:"generation"`  // Generation introduced the species
+
+	// EvolvesFromSpecies is the species this one evolves from, nil for the
+	// first species of an evolution chain.
+	EvolvesFromSpecies *NamedAPIResource `json:"evolves_from_species"`
+
+	// EvolutionChain refers to the evolution chain the species belongs to.
+	EvolutionChain APIResource `json:"evolution_chain"`
+
+	FlavorTextEntries []FlavorText            `json:"flavor_text_entries"` // FlavorTextEntries are the Pokedex entries of every game
+	Genera            []Genus                 `json:"genera"`              // Genera are the localized genus, e.g. "Seed Pokemon"
+	Names             []Name                  `json:"names"`               // Names are the localized names of the species
+	Varieties         []PokemonSpeciesVariety `json:"varieties"`           // Varieties are the Pokemon that are forms of the species
+}
+
+// APIResource refers to a resource by URL only, as for evolution chains,
+// which have no name.
+type APIResource struct {
+	URL string `json:"url"` // URL is where the resource can be requested
+}
+
+// ID returns the ID at the end of the resource URL, e.g. 67 for
+// "https://pokeapi.co/api/v2/evolution-chain/67/".
+func (r APIResource) ID() (int, bool) {
+	url := strings.TrimRight(r.URL, "/")
+	id, err := strconv.Atoi(url[strings.LastIndex(url, "/")+1:])
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// FlavorText is the Pokedex entry of a species in one game and language.
+type FlavorText struct {
+	FlavorText string           `json:"flavor_text"` // FlavorText is the entry, with the line breaks of the game
+	Language   NamedAPIResource `json:"language"`    // Language of the entry, e.g. "en"
+	Version    NamedAPIResource `json:"version"`     // Version is the game the entry appears in
+}
+
+// Genus is the localized genus of a species.
+type Genus struct {
+	Genus    string           `json:"genus"`    // Genus is the genus, e.g. "Seed Pokemon"
+	Language NamedAPIResource `json:"language"` // Language of the genus
+}
+
+// Name is the localized name of a resource.
+type Name struct {
+	Name     string           `json:"name"`     // Name is the localized name
+	Language NamedAPIResource `json:"language"` // Language of the name
+}
+
+// PokemonSpeciesVariety is one of the Pokemon that are forms of a species.
+type PokemonSpeciesVariety struct {
+	IsDefault bool             `json:"is_default"` // IsDefault is set for the form the species is usually seen in
+	Pokemon   NamedAPIResource `json:"pokemon"`    // Pokemon is the Pokemon of this form
+}
+
+// FlavorText returns the most recent Pokedex entry of the species in
+// language, e.g. "en", on a single line, or "" if there is none.
+func (s PokemonSpecies) FlavorText(language string) string {
+	// Entries are listed from the oldest game to the newest
+	for i := len(s.FlavorTextEntries) - 1; i >= 0; i-- {
+		entry := s.FlavorTextEntries[i]
+		if entry.Language.Name == language {
+			// The games break lines and pages with \n and \f
+			return strings.Join(strings.Fields(entry.FlavorText), " ")
+		}
+	}
+	return ""
+}
+
+// Genus returns the genus of the species in language, or "" if there is none.
+func (s PokemonSpecies) Genus(language string) string {
+	for _, genus := range s.Genera {
+		if genus.Language.Name == language {
+			return genus.Genus
+		}
+	}
+	return ""
+}
+
+// LocalizedName returns the name of the species in language, or its
+// API name if there is no such translation.
+func (s PokemonSpecies) LocalizedName(language string) string {
+	for _, name := range s.Names {
+		if name.Language.Name == language {
+			return name.Name
+		}
+	}
+	return s.Name
+}
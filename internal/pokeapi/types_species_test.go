@@ -0,0 +1,64 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPokemonSpeciesLocalization(t *testing.T) {
+	dat := []byte(`{
+		"name": "bulbasaur",
+		"base_happiness": 50,
+		"evolution_chain": {"url": "https://pokeapi.co/api/v2/evolution-chain/1/"},
+		"flavor_text_entries": [
+			{"flavor_text": "An old\nentry.", "language": {"name": "en"}, "version": {"name": "red"}},
+			{"flavor_text": "Ein Eintrag.", "language": {"name": "de"}, "version": {"name": "x"}},
+			{"flavor_text": "A strange seed was\nplanted on its\fback at birth.", "language": {"name": "en"}, "version": {"name": "x"}}
+		],
+		"genera": [{"genus": "Seed Pokémon", "language": {"name": "en"}}],
+		"names": [{"name": "Bisasam", "language": {"name": "de"}}]
+	}`)
+	var species PokemonSpecies
+	if err := json.Unmarshal(dat, &species); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if text := species.FlavorText("en"); text != "A strange seed was planted on its back at birth." {
+		t.Errorf("expected the newest english entry on one line, got %q", text)
+	}
+	if text := species.FlavorText("fr"); text != "" {
+		t.Errorf("expected no french entry, got %q", text)
+	}
+	if genus := species.Genus("en"); genus != "Seed Pokémon" {
+		t.Errorf("unexpected genus %q", genus)
+	}
+	if name := species.LocalizedName("de"); name != "Bisasam" {
+		t.Errorf("unexpected german name %q", name)
+	}
+	if name := species.LocalizedName("ja"); name != "bulbasaur" {
+		t.Errorf("expected the API name without a translation, got %q", name)
+	}
+	if species.BaseHappiness == nil || *species.BaseHappiness != 50 || species.EvolutionChain.URL == "" {
+		t.Errorf("unexpected species %+v", species)
+	}
+}
+
+func TestAPIResourceID(t *testing.T) {
+	cases := []struct {
+		url string
+		id  int
+		ok  bool
+	}{
+		{url: "https://pokeapi.co/api/v2/evolution-chain/67/", id: 67, ok: true},
+		{url: "https://pokeapi.co/api/v2/evolution-chain/1", id: 1, ok: true},
+		{url: "https://pokeapi.co/api/v2/evolution-chain/", ok: false},
+		{url: "", ok: false},
+	}
+
+	for _, c := range cases {
+		id, ok := APIResource{URL: c.url}.ID()
+		if id != c.id || ok != c.ok {
+			t.Errorf("ID of %q == %d, %v, expected %d, %v", c.url, id, ok, c.id, c.ok)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package pokeapi
+
+// Type is an elemental type of Pokemon and moves, e.g. "fire".
+type Type struct {
+	ID   int    `json:"id"`   // ID identifies the type
+	Name string `json:"name"` // Name is the type name, e.g. "fire"
+
+	// DamageRelations is how the type fares against the other types.
+	DamageRelations TypeRelations `json:"damage_relations"`
+
+	Pokemon []TypePokemon      `json:"pokemon"` // Pokemon are the Pokemon of this type
+	Moves   []NamedAPIResource `json:"moves"`   // Moves are the moves of this type
+	Names   []Name             `json:"names"`   // Names are the localized names of the type
+}
+
+// TypeRelations lists the types a type is strong or weak against, in
+// attack ("to") and in defense ("from"). Types not listed deal and take
+// regular damage.
+type TypeRelations struct {
+	NoDamageTo       []NamedAPIResource `json:"no_damage_to"`       // NoDamageTo are the types its moves don't affect
+	HalfDamageTo     []NamedAPIResource `json:"half_damage_to"`     // HalfDamageTo resist its moves
+	DoubleDamageTo   []NamedAPIResource `json:"double_damage_to"`   // DoubleDamageTo are weak to its moves
+	NoDamageFrom     []NamedAPIResource `json:"no_damage_from"`     // NoDamageFrom can't affect it
+	HalfDamageFrom   []NamedAPIResource `json:"half_damage_from"`   // HalfDamageFrom it resists
+	DoubleDamageFrom []NamedAPIResource `json:"double_damage_from"` // DoubleDamageFrom it is weak to
+}
+
+// TypePokemon is a Pokemon of a type, with the slot the type is in.
+type TypePokemon struct {
+	Slot    int              `json:"slot"`    // Slot is 1 for the primary type, 2 for the secondary
+	Pokemon NamedAPIResource `json:"pokemon"` // Pokemon is the Pokemon
+}
+
+// MultiplierTo returns the damage multiplier of the type's moves against a
+// Pokemon of the single type defending: 0, 0.5, 1 or 2.
+func (t Type) MultiplierTo(defending string) float64 {
+	return multiplier(defending, t.DamageRelations.NoDamageTo, t.DamageRelations.HalfDamageTo, t.DamageRelations.DoubleDamageTo)
+}
+
+// MultiplierFrom returns the damage multiplier of moves of type attacking
+// against a Pokemon of this single type: 0, 0.5, 1 or 2.
+func (t Type) MultiplierFrom(attacking string) float64 {
+	return multiplier(attacking, t.DamageRelations.NoDamageFrom, t.DamageRelations.HalfDamageFrom, t.DamageRelations.DoubleDamageFrom)
+}
+
+// DefenseMultiplier returns the combined damage multiplier of moves of type
+// attacking against a Pokemon with the given types. For dual-typed Pokemon
+// the multipliers of both types are multiplied, so the result is one of 0,
+// 0.25, 0.5, 1, 2 or 4.
+func DefenseMultiplier(attacking string, defending []Type) float64 {
+	total := 1.0
+	for _, t := range defending {
+		total *= t.MultiplierFrom(attacking)
+	}
+	return total
+}
+
+// multiplier returns the multiplier for typeName given the types of each relation.
+func multiplier(typeName string, none, half, double []NamedAPIResource) float64 {
+	switch {
+	case containsResource(none, typeName):
+		return 0
+	case containsResource(half, typeName):
+		return 0.5
+	case containsResource(double, typeName):
+		return 2
+	default:
+		return 1
+	}
+}
+
+// containsResource reports whether resources has one named name.
+func containsResource(resources []NamedAPIResource, name string) bool {
+	for _, r := range resources {
+		if r.Name == name {
+			return true
+		}
+	}
+	return false
+}
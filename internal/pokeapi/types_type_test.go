@@ -0,0 +1,46 @@
+package pokeapi
+
+import "testing"
+
+// named returns resources with the given names.
+func named(names ...string) []NamedAPIResource {
+	resources := make([]NamedAPIResource, 0, len(names))
+	for _, name := range names {
+		resources = append(resources, NamedAPIResource{Name: name})
+	}
+	return resources
+}
+
+func TestDefenseMultiplier(t *testing.T) {
+	water := Type{Name: "water", DamageRelations: TypeRelations{
+		HalfDamageFrom:   named("steel", "fire", "water", "ice"),
+		DoubleDamageFrom: named("grass", "electric"),
+	}}
+	flying := Type{Name: "flying", DamageRelations: TypeRelations{
+		NoDamageFrom:     named("ground"),
+		HalfDamageFrom:   named("fighting", "bug", "grass"),
+		DoubleDamageFrom: named("electric", "ice", "rock"),
+	}}
+
+	cases := []struct {
+		attacking string
+		expected  float64
+	}{
+		{attacking: "electric", expected: 4},
+		{attacking: "rock", expected: 2},
+		{attacking: "normal", expected: 1},
+		{attacking: "grass", expected: 1},
+		{attacking: "ice", expected: 1},
+		{attacking: "fire", expected: 0.5},
+		{attacking: "ground", expected: 0},
+	}
+
+	for _, c := range cases {
+		if actual := DefenseMultiplier(c.attacking, []Type{water, flying}); actual != c.expected {
+			t.Errorf("DefenseMultiplier(%s, water/flying) == %v, expected %v", c.attacking, actual, c.expected)
+		}
+	}
+	if actual := DefenseMultiplier("electric", []Type{water}); actual != 2 {
+		t.Errorf("expected 2x against a single type, got %v", actual)
+	}
+}
@@ -0,0 +1,35 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+)
+
+// forEach calls fn with every index from 0 to n-1, from workers goroutines
+// at once, and returns when all calls have returned. Once ctx is cancelled,
+// the remaining indexes are skipped; fn is expected to watch ctx itself to
+// stop the calls already running.
+func forEach(ctx context.Context, workers, n int, fn func(i int)) {
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+
+feed:
+	for i := 0; i < n; i++ {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+}
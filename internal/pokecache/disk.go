@@ -0,0 +1,271 @@
+package pokecache
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// diskRetention is how long expired entries are kept on disk. They are no
+// longer served by Get, but Peek still returns them so they can be
+// revalidated with the server instead of downloaded again.
+const diskRetention = 30 * 24 * time.Hour
+
+// DiskCache is a cache that stores each entry in its own file, so entries
+// survive restarts. Files are named after the SHA-256 hash of their key.
+type DiskCache struct {
+	dir         string        // dir is the directory holding the cache files
+	maxAge      time.Duration // maxAge is how long entries added with Add stay valid
+	hits        atomic.Int64  // hits counts Get calls that found a value
+	misses      atomic.Int64  // misses counts Get calls that found nothing
+	expirations atomic.Int64  // expirations counts entries removed because they were too old
+}
+
+// diskHeader is stored as JSON on the first line of every cache file,
+// followed by the value.
+type diskHeader struct {
+	Key          string    `json:"key"`                     // Key lets reads detect hash collisions
+	ExpiresAt    time.Time `json:"expires_at"`              // ExpiresAt is when the entry stops being fresh
+	ETag         string    `json:"etag,omitempty"`          // ETag validator of the cached response
+	LastModified string    `json:"last_modified,omitempty"` // Last-Modified validator of the cached response
+}
+
+// NewDiskCache creates a DiskCache in dir, creating the directory if needed.
+// Entries added with Add expire after maxAge; expired entries are treated as
+// missing, and removed once they are older than the retention period.
+func NewDiskCache(dir string, maxAge time.Duration) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir, maxAge: maxAge}, nil
+}
+
+// Add stores value under key for the cache's default maxAge.
+func (d *DiskCache) Add(key string, value []byte) {
+	d.AddWithTTL(key, value, d.maxAge)
+}
+
+// AddWithTTL stores value under key until ttl has elapsed.
+func (d *DiskCache) AddWithTTL(key string, value []byte, ttl time.Duration) {
+	d.AddEntry(key, Entry{Value: value}, ttl)
+}
+
+// AddEntry stores a value and its HTTP validators under key until ttl has
+// elapsed. The disk cache is best-effort: a failed write only means the entry
+// will be fetched again later, so errors are dropped.
+func (d *DiskCache) AddEntry(key string, entry Entry, ttl time.Duration) {
+	header, err := json.Marshal(diskHeader{
+		Key:          key,
+		ExpiresAt:    time.Now().Add(ttl),
+		ETag:         entry.ETag,
+		LastModified: entry.LastModified,
+	})
+	if err != nil {
+		return
+	}
+	dat := make([]byte, 0, len(header)+1+len(entry.Value))
+	dat = append(dat, header...)
+	dat = append(dat, '\n')
+	dat = append(dat, entry.Value...)
+
+	tmp, err := os.CreateTemp(d.dir, "entry-*.tmp")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(dat); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	os.Rename(tmp.Name(), d.path(key))
+}
+
+// Get fetches the value stored under key, if present and not expired.
+func (d *DiskCache) Get(key string) ([]byte, bool) {
+	entry, expiresAt, ok := d.entry(key)
+	if !ok || time.Now().After(expiresAt) {
+		d.misses.Add(1)
+		return nil, false
+	}
+	d.hits.Add(1)
+	return entry.Value, true
+}
+
+// GetStale fetches the value stored under key like Get. Expired entries are
+// not served from disk, so the value is never stale.
+func (d *DiskCache) GetStale(key string) (val []byte, stale bool, ok bool) {
+	val, ok = d.Get(key)
+	return val, false, ok
+}
+
+// Peek returns the entry stored under key with its validators, even if it
+// has expired, as long as it is within the retention period. Peek doesn't
+// count as a hit or miss.
+func (d *DiskCache) Peek(key string) (Entry, bool) {
+	entry, _, ok := d.entry(key)
+	return entry, ok
+}
+
+// entry returns the entry stored under key with its validators and the time
+// it stops being fresh, like Peek.
+func (d *DiskCache) entry(key string) (Entry, time.Time, bool) {
+	header, val, ok := d.read(d.path(key))
+	if !ok || header.Key != key {
+		return Entry{}, time.Time{}, false
+	}
+	return Entry{Value: val, ETag: header.ETag, LastModified: header.LastModified}, header.ExpiresAt, true
+}
+
+// Delete removes the entry stored under key, if any.
+func (d *DiskCache) Delete(key string) {
+	os.Remove(d.path(key))
+}
+
+// Len returns the number of entries stored on disk, including expired
+// entries that are kept for revalidation.
+func (d *DiskCache) Len() int {
+	return len(d.files())
+}
+
+// Keys returns the keys of all stored entries, in no particular order.
+func (d *DiskCache) Keys() []string {
+	keys := []string{}
+	for _, path := range d.files() {
+		if header, _, ok := d.readHeader(path); ok {
+			keys = append(keys, header.Key)
+		}
+	}
+	return keys
+}
+
+// Clear removes every entry from the disk.
+func (d *DiskCache) Clear() {
+	for _, path := range d.files() {
+		os.Remove(path)
+	}
+}
+
+// Stats returns the activity counters and the size of the entries on disk.
+func (d *DiskCache) Stats() Stats {
+	stats := Stats{
+		Hits:        d.hits.Load(),
+		Misses:      d.misses.Load(),
+		Expirations: d.expirations.Load(),
+	}
+	for _, path := range d.files() {
+		header, size, ok := d.readHeader(path)
+		if !ok {
+			continue
+		}
+		stats.Entries++
+		stats.Bytes += len(header.Key) + size
+	}
+	return stats
+}
+
+// Close is a no-op, every write is already flushed to disk by AddEntry.
+func (d *DiskCache) Close() error {
+	return nil
+}
+
+// read returns the header and value stored in the file at path. Files past
+// the retention period, and files that can't be parsed, such as files
+// written by older releases, are removed instead.
+func (d *DiskCache) read(path string) (diskHeader, []byte, bool) {
+	f, err := os.Open(path)
+	if err != nil {
+		return diskHeader{}, nil, false
+	}
+	r := bufio.NewReader(f)
+	header, _, headerErr := decodeHeader(r)
+	var val []byte
+	if headerErr == nil {
+		val, err = io.ReadAll(r)
+	}
+	f.Close()
+
+	if !d.keep(path, header, headerErr) || err != nil {
+		return diskHeader{}, nil, false
+	}
+	return header, val, true
+}
+
+// readHeader returns the header stored in the file at path and the size of
+// the value following it, without reading the value. Like read, it removes
+// files that are past the retention period or can't be parsed.
+func (d *DiskCache) readHeader(path string) (diskHeader, int, bool) {
+	f, err := os.Open(path)
+	if err != nil {
+		return diskHeader{}, 0, false
+	}
+	header, n, headerErr := decodeHeader(bufio.NewReader(f))
+	info, err := f.Stat()
+	f.Close()
+
+	if !d.keep(path, header, headerErr) || err != nil {
+		return diskHeader{}, 0, false
+	}
+	return header, int(info.Size()) - n, true
+}
+
+// decodeHeader reads the header line at the start of a cache file from r.
+// It also returns the length of the line, including the newline.
+func decodeHeader(r *bufio.Reader) (diskHeader, int, error) {
+	line, err := r.ReadBytes('\n')
+	if err != nil {
+		return diskHeader{}, 0, err
+	}
+	header := diskHeader{}
+	if err := json.Unmarshal(line, &header); err != nil {
+		return diskHeader{}, 0, err
+	}
+	return header, len(line), nil
+}
+
+// keep reports whether the cache file at path, whose header was decoded
+// with err, is still worth keeping, and removes it if not.
+func (d *DiskCache) keep(path string, header diskHeader, err error) bool {
+	if err != nil {
+		os.Remove(path)
+		return false
+	}
+	if time.Now().After(header.ExpiresAt.Add(diskRetention)) {
+		if os.Remove(path) == nil {
+			d.expirations.Add(1)
+		}
+		return false
+	}
+	return true
+}
+
+// files returns the paths of all entry files, skipping in-progress writes.
+func (d *DiskCache) files() []string {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return nil
+	}
+	paths := []string{}
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && !strings.HasSuffix(entry.Name(), ".tmp") {
+			paths = append(paths, filepath.Join(d.dir, entry.Name()))
+		}
+	}
+	return paths
+}
+
+// path returns the file used to store key.
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:]))
+}
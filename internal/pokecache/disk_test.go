@@ -0,0 +1,170 @@
+package pokecache
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestDiskCacheSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+
+	first, err := NewDiskCache(dir, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first.Add("https://example.com", []byte("testdata"))
+
+	second, err := NewDiskCache(dir, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	val, ok := second.Get("https://example.com")
+	if !ok {
+		t.Errorf("expected to find key")
+		return
+	}
+	if string(val) != "testdata" {
+		t.Errorf("expected to find value, got %q", val)
+	}
+}
+
+func TestDiskCacheExpiry(t *testing.T) {
+	disk, err := NewDiskCache(t.TempDir(), time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	disk.AddWithTTL("https://example.com", []byte("testdata"), -time.Second)
+	disk.AddWithTTL("https://example.com/old", []byte("olddata"), -diskRetention-time.Second)
+	disk.AddWithTTL("https://example.com/path", []byte("moretestdata"), time.Hour)
+
+	if _, ok := disk.Get("https://example.com"); ok {
+		t.Errorf("expected to not find key")
+	}
+	if _, ok := disk.Peek("https://example.com"); !ok {
+		t.Errorf("expected expired entry to be kept for revalidation")
+	}
+	if _, ok := disk.Peek("https://example.com/old"); ok {
+		t.Errorf("expected to not find key past retention")
+	}
+	if _, err := os.Stat(disk.path("https://example.com/old")); !os.IsNotExist(err) {
+		t.Errorf("expected entry past retention to be removed")
+	}
+	if _, ok := disk.Get("https://example.com/path"); !ok {
+		t.Errorf("expected per-entry TTL to override the default")
+	}
+}
+
+func TestDiskCacheStats(t *testing.T) {
+	disk, err := NewDiskCache(t.TempDir(), time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	disk.AddEntry("https://example.com", Entry{Value: []byte("testdata"), ETag: `"abc"`}, time.Hour)
+	disk.Add("https://example.com/path", []byte("moretestdata"))
+	// A file from an older release without a header line
+	os.WriteFile(disk.path("https://example.com/old"), []byte("olddata"), 0o644)
+
+	// The sizes come from the headers and the file sizes
+	stats := disk.Stats()
+	expectedBytes := len("https://example.com") + len("testdata") + len("https://example.com/path") + len("moretestdata")
+	if stats.Entries != 2 || stats.Bytes != expectedBytes {
+		t.Errorf("expected 2 entries of %d bytes, got %+v", expectedBytes, stats)
+	}
+	if keys := disk.Keys(); len(keys) != 2 {
+		t.Errorf("expected 2 keys, got %v", keys)
+	}
+	if _, err := os.Stat(disk.path("https://example.com/old")); !os.IsNotExist(err) {
+		t.Errorf("expected the unparseable file to be removed")
+	}
+}
+
+func TestTieredCachePromotesFromDisk(t *testing.T) {
+	disk, err := NewDiskCache(t.TempDir(), time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	disk.Add("https://example.com", []byte("testdata"))
+
+	mem := NewCache(time.Hour)
+	tiered := NewTieredCache(mem, disk)
+	defer tiered.Close()
+	if _, ok := tiered.Get("https://example.com"); !ok {
+		t.Errorf("expected to find key on disk")
+		return
+	}
+	if _, ok := mem.Get("https://example.com"); !ok {
+		t.Errorf("expected key to be promoted to memory")
+	}
+
+	tiered.Get("https://example.com/missing")
+	stats := tiered.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Entries != 1 {
+		t.Errorf("expected 1 hit, 1 miss and 1 entry, got %+v", stats)
+	}
+}
+
+func TestTieredCachePromotesEntry(t *testing.T) {
+	disk, err := NewDiskCache(t.TempDir(), time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	disk.AddEntry("https://example.com", Entry{Value: []byte("testdata"), ETag: `"abc"`}, 50*time.Millisecond)
+
+	mem := NewCache(time.Hour)
+	tiered := NewTieredCache(mem, disk)
+	defer tiered.Close()
+	if _, ok := tiered.Get("https://example.com"); !ok {
+		t.Fatalf("expected to find key on disk")
+	}
+
+	// The copy in memory keeps the validators and the TTL of the disk entry
+	if entry, ok := mem.Peek("https://example.com"); !ok || entry.ETag != `"abc"` {
+		t.Errorf("expected the ETag to be promoted, got %+v", entry)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if _, ok := mem.Get("https://example.com"); ok {
+		t.Errorf("expected the promoted entry to expire with the disk entry")
+	}
+}
+
+func TestTieredCacheStaleStats(t *testing.T) {
+	mem := NewBoundedCache(time.Hour, Limits{StaleFor: time.Hour})
+	tiered := NewTieredCache(mem, nil)
+	defer tiered.Close()
+	mem.AddWithTTL("https://example.com/stale", []byte("testdata"), time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+
+	if _, stale, ok := tiered.GetStale("https://example.com/stale"); !ok || !stale {
+		t.Errorf("expected a stale value, got stale %v ok %v", stale, ok)
+	}
+	tiered.GetStale("https://example.com/missing")
+
+	// Every lookup is counted exactly once
+	stats := tiered.Stats()
+	if stats.Hits != 0 || stats.StaleHits != 1 || stats.Misses != 1 {
+		t.Errorf("expected 1 stale hit and 1 miss, got %+v", stats)
+	}
+}
+
+func TestDiskCacheValidators(t *testing.T) {
+	dir := t.TempDir()
+	disk, err := NewDiskCache(dir, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	entry := Entry{Value: []byte("testdata"), ETag: `"abc"`, LastModified: "Mon, 02 Jan 2006 15:04:05 GMT"}
+	disk.AddEntry("https://example.com", entry, time.Hour)
+
+	reopened, err := NewDiskCache(dir, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	peeked, ok := reopened.Peek("https://example.com")
+	if !ok {
+		t.Fatalf("expected to find key")
+	}
+	if string(peeked.Value) != "testdata" || peeked.ETag != entry.ETag || peeked.LastModified != entry.LastModified {
+		t.Errorf("expected %+v, got %+v", entry, peeked)
+	}
+}
@@ -0,0 +1,10 @@
+package pokecache
+
+// Entry is a cached value together with the HTTP validators of the response
+// it came from. The validators let a client ask the server whether an
+// expired value is still current instead of downloading it again.
+type Entry struct {
+	Value        []byte // Value is the cached response body
+	ETag         string // ETag is the ETag header of the response, if any
+	LastModified string // LastModified is the Last-Modified header of the response, if any
+}
@@ -0,0 +1,57 @@
+package pokecache
+
+import "time"
+
+// NoopCache is a cache that stores nothing. It is useful for tests and
+// for always fetching fresh data.
+type NoopCache struct{}
+
+// Add discards value.
+func (NoopCache) Add(key string, value []byte) {}
+
+// Get always reports that key is missing.
+func (NoopCache) Get(key string) ([]byte, bool) {
+	return nil, false
+}
+
+// AddWithTTL discards value.
+func (NoopCache) AddWithTTL(key string, value []byte, ttl time.Duration) {}
+
+// GetStale always reports that key is missing.
+func (NoopCache) GetStale(key string) (val []byte, stale bool, ok bool) {
+	return nil, false, false
+}
+
+// AddEntry discards entry.
+func (NoopCache) AddEntry(key string, entry Entry, ttl time.Duration) {}
+
+// Peek always reports that key is missing.
+func (NoopCache) Peek(key string) (Entry, bool) {
+	return Entry{}, false
+}
+
+// Delete does nothing.
+func (NoopCache) Delete(key string) {}
+
+// Len always returns 0.
+func (NoopCache) Len() int {
+	return 0
+}
+
+// Keys always returns no keys.
+func (NoopCache) Keys() []string {
+	return nil
+}
+
+// Clear does nothing.
+func (NoopCache) Clear() {}
+
+// Stats always returns empty Stats.
+func (NoopCache) Stats() Stats {
+	return Stats{}
+}
+
+// Close does nothing.
+func (NoopCache) Close() error {
+	return nil
+}
@@ -1,76 +1,303 @@
-// pokecache package implements a simple in-memory cache system
+// pokecache package implements simple in-memory and on-disk cache systems
 
 package pokecache
 
 import (
+	"container/list"
+	"context"
 	"sync"
 	"time"
 )
 
 // Cache is a thread-safe map used to cache data.
+// Entries expire after their TTL, which defaults to the reap interval, and,
+// when Limits are set, the least recently used entries are evicted to stay
+// within them. Expired entries can still be read with GetStale for
+// Limits.StaleFor before they are reaped.
 type Cache struct {
-	cache map[string]cacheEntry // cache  is a map which contains the cached data
-	mux   *sync.Mutex           // mux is a Mutex which is used for handling access from multiple routines
+	cache  map[string]cacheEntry // cache  is a map which contains the cached data
+	ttl    time.Duration         // ttl is how long entries added with Add stay fresh
+	lru    *list.List            // lru orders the keys from most (front) to least (back) recently used
+	bytes  int                   // bytes is the total size of all entries
+	limits Limits                // limits bounds the number and total size of entries
+	stats  Stats                 // stats counts hits, misses, evictions and expirations
+	mux    *sync.Mutex           // mux is a Mutex which is used for handling access from multiple routines
+	stop   context.CancelFunc    // stop cancels the context of the reap loop
+	done   chan struct{}         // done is closed once the reap loop has returned
+}
+
+// Limits bounds the size of a Cache. A zero field means no limit.
+type Limits struct {
+	MaxEntries int // MaxEntries is the maximum number of entries
+	MaxBytes   int // MaxBytes is the maximum total size of all keys and values
+
+	// StaleFor is how long expired entries are kept so GetStale can serve
+	// them while they are refreshed. Zero removes entries once they expire.
+	StaleFor time.Duration
 }
 
 // cacheEntry is a single entity/item stored in the cache
 type cacheEntry struct {
-	createdAt time.Time // Time when the cache entry was created
-	val       []byte    // Value of the cache entry
+	createdAt time.Time     // Time when the cache entry was created
+	expiresAt time.Time     // Time after which the cache entry is no longer fresh
+	val       []byte        // Value of the cache entry
+	etag      string        // ETag of the response the value came from
+	modified  string        // Last-Modified of the response the value came from
+	size      int           // Number of bytes accounted to the entry
+	elem      *list.Element // Position of the entry's key in the LRU list
+}
+
+// entrySize returns the number of bytes accounted to an entry: its key and value.
+func entrySize(key string, value []byte) int {
+	return len(key) + len(value)
 }
 
-// NewCache function creates a new Cache.
-func NewCache(interval time.Duration) Cache {
+// NewCache function creates a new Cache without size limits.
+func NewCache(interval time.Duration) *Cache {
+	return NewBoundedCache(interval, Limits{})
+}
+
+// NewBoundedCache function creates a new Cache that holds at most the
+// number of entries and bytes given by limits. Entries added with Add stay
+// fresh for interval, which is also how often expired entries are reaped.
+func NewBoundedCache(interval time.Duration, limits Limits) *Cache {
+	// The reap loop runs until this context is cancelled by Close.
+	ctx, stop := context.WithCancel(context.Background())
+
 	// A Cache struct is being initialized with an empty cache and a new mutex.
-	c := Cache{
-		cache: make(map[string]cacheEntry), // Creating an empty map of string keys to cacheEntry values.
-		mux:   &sync.Mutex{},               // Initializing a new mutex for handling concurrent access to the cache.
+	c := &Cache{
+		cache:  make(map[string]cacheEntry), // Creating an empty map of string keys to cacheEntry values.
+		ttl:    interval,                    // Using the reap interval as the default TTL.
+		lru:    list.New(),                  // Creating an empty LRU list.
+		limits: limits,                      // Storing the limits that are enforced on every Add.
+		mux:    &sync.Mutex{},               // Initializing a new mutex for handling concurrent access to the cache.
+		stop:   stop,                        // Storing the function that stops the reap loop.
+		done:   make(chan struct{}),         // Creating the channel closed when the reap loop returns.
 	}
 
 	// Invoking the reapLoop method in a separate goroutine, which continually clears expired entries from the cache at the provided interval.
-	go c.reapLoop(interval)
+	go c.reapLoop(ctx, interval)
 
 	// Returning the newly created cache.
 	return c
 }
 
-// Add method adds a new entry to the cache. It is thread-safe, i.e.,
-// it permits concurrent access to the cache.
+// Add method adds a new entry to the cache that stays fresh for the cache's
+// default TTL. It is thread-safe, i.e., it permits concurrent access to the cache.
 func (c *Cache) Add(key string, value []byte) {
+	c.AddWithTTL(key, value, c.ttl)
+}
+
+// AddWithTTL method adds a new entry to the cache that stays fresh for ttl.
+// It is thread-safe.
+func (c *Cache) AddWithTTL(key string, value []byte, ttl time.Duration) {
+	c.AddEntry(key, Entry{Value: value}, ttl)
+}
+
+// AddEntry method adds a value and its HTTP validators to the cache, fresh for ttl.
+// It is thread-safe.
+// If the cache grows beyond its limits, the least recently used entries are evicted.
+// A value too large to ever fit within MaxBytes is not stored.
+func (c *Cache) AddEntry(key string, entry Entry, ttl time.Duration) {
 	c.mux.Lock()         // Lock before writing to the cache
 	defer c.mux.Unlock() // Unlock after writing to the cache is complete
+
+	// Replace any previous value stored under the same key
+	c.remove(key)
+
+	size := entrySize(key, entry.Value)
+	if c.limits.MaxBytes > 0 && size > c.limits.MaxBytes {
+		return
+	}
+
+	now := time.Now()
 	c.cache[key] = cacheEntry{
-		createdAt: time.Now(), // set creation time
-		val:       value,      // store value
+		createdAt: now,                  // set creation time
+		expiresAt: now.Add(ttl),         // set expiry time
+		val:       entry.Value,          // store value
+		etag:      entry.ETag,           // store ETag validator
+		modified:  entry.LastModified,   // store Last-Modified validator
+		size:      size,                 // account its size
+		elem:      c.lru.PushFront(key), // mark it as most recently used
 	}
+	c.bytes += size
+
+	c.evict()
 }
 
-// Get method fetches an entry from the cache. It is thread-safe, i.e.,
+// Get method fetches a fresh entry from the cache. It is thread-safe, i.e.,
 // it permits concurrent access to the cache.
+// A successful Get marks the entry as most recently used.
 func (c *Cache) Get(key string) ([]byte, bool) {
 	c.mux.Lock()         // Lock before reading the cache
 	defer c.mux.Unlock() // Unlock after reading the cache is complete
 	val, ok := c.cache[key]
-	return val.val, ok // returns value and a boolean indicating if a value was found
+	if !ok || time.Now().After(val.expiresAt) {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	c.lru.MoveToFront(val.elem)
+	return val.val, true // returns value and a boolean indicating if a value was found
+}
+
+// GetStale method fetches an entry from the cache, including an expired
+// entry that is still within the Limits.StaleFor window. stale reports
+// whether the value has expired and should be refreshed. It is thread-safe.
+func (c *Cache) GetStale(key string) (val []byte, stale bool, ok bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	entry, ok := c.cache[key]
+	now := time.Now()
+	if !ok || now.After(entry.expiresAt.Add(c.limits.StaleFor)) {
+		c.stats.Misses++
+		return nil, false, false
+	}
+
+	c.lru.MoveToFront(entry.elem)
+	if now.After(entry.expiresAt) {
+		c.stats.StaleHits++
+		return entry.val, true, true
+	}
+	c.stats.Hits++
+	return entry.val, false, true
+}
+
+// Peek method returns the entry stored under key with its validators, even
+// if it has expired, as long as it hasn't been reaped yet. Peek doesn't count
+// as a hit or miss, nor as a use of the entry. It is thread-safe.
+func (c *Cache) Peek(key string) (Entry, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	entry, ok := c.cache[key]
+	if !ok {
+		return Entry{}, false
+	}
+	return Entry{Value: entry.val, ETag: entry.etag, LastModified: entry.modified}, true
+}
+
+// Delete method removes the entry stored under key, if any. It is thread-safe.
+func (c *Cache) Delete(key string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.remove(key)
+}
+
+// Len method returns the number of entries in the cache. It is thread-safe.
+func (c *Cache) Len() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return len(c.cache)
+}
+
+// Bytes method returns the total size of all entries in the cache. It is thread-safe.
+func (c *Cache) Bytes() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.bytes
+}
+
+// Keys method returns the keys of all entries, from most to least recently used.
+// It is thread-safe.
+func (c *Cache) Keys() []string {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	keys := make([]string, 0, len(c.cache))
+	for e := c.lru.Front(); e != nil; e = e.Next() {
+		keys = append(keys, e.Value.(string))
+	}
+	return keys
+}
+
+// Stats method returns a snapshot of the cache counters and size. It is thread-safe.
+func (c *Cache) Stats() Stats {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	stats := c.stats
+	stats.Entries = len(c.cache)
+	stats.Bytes = c.bytes
+	return stats
+}
+
+// Clear method removes all entries from the cache. It is thread-safe.
+func (c *Cache) Clear() {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.cache = make(map[string]cacheEntry)
+	c.lru.Init()
+	c.bytes = 0
+}
+
+// Close method stops the reap loop and releases all entries held by the cache.
+// It waits for the reap loop to return, and is safe to call more than once.
+func (c *Cache) Close() error {
+	c.stop()
+	<-c.done
+	c.Clear()
+	return nil
+}
+
+// remove deletes the entry stored under key and its accounting.
+// The caller must hold the mutex.
+func (c *Cache) remove(key string) {
+	entry, ok := c.cache[key]
+	if !ok {
+		return
+	}
+	c.lru.Remove(entry.elem)
+	c.bytes -= entry.size
+	delete(c.cache, key)
+}
+
+// evict removes least recently used entries until the cache is within its limits.
+// The caller must hold the mutex.
+func (c *Cache) evict() {
+	for c.overLimits() {
+		oldest := c.lru.Back()
+		if oldest == nil {
+			return
+		}
+		c.remove(oldest.Value.(string))
+		c.stats.Evictions++
+	}
+}
+
+// overLimits reports whether the cache holds more entries or bytes than allowed.
+// The caller must hold the mutex.
+func (c *Cache) overLimits() bool {
+	if c.limits.MaxEntries > 0 && len(c.cache) > c.limits.MaxEntries {
+		return true
+	}
+	return c.limits.MaxBytes > 0 && c.bytes > c.limits.MaxBytes
 }
 
 // reapLoop is a method on Cache struct that runs a loop at specific intervals.
 // Each time the interval elapses, the method invokes the 'reap' method.
-func (c *Cache) reapLoop(interval time.Duration) {
+// The loop returns once ctx is cancelled.
+func (c *Cache) reapLoop(ctx context.Context, interval time.Duration) {
+	// Signal Close that the loop has returned
+	defer close(c.done)
+
 	// Create a new ticker that triggers at intervals based on the specified duration.
 	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
 	// The loop will continuously wait for the ticker's channel to send a signal
 	// It triggers once the time interval specified when creating the ticker elapses
-	for range ticker.C {
-		// After each trigger, call the reap method on the Cache. Pass the current time and the interval.
-		c.reap(time.Now().UTC(), interval)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// After each trigger, call the reap method on the Cache. Pass the current time.
+			c.reap(time.Now().UTC())
+		}
 	}
 }
 
-// `reap` is a method on `Cache` struct which clears items from the cache that were created
-// before a specified time duration (`last`). The `now` parameter represents the current time.
-func (c *Cache) reap(now time.Time, last time.Duration) {
+// `reap` is a method on `Cache` struct which clears items from the cache that expired
+// longer ago than the stale window. The `now` parameter represents the current time.
+func (c *Cache) reap(now time.Time) {
 	// Locking the mutex to prevent concurrent read/write on the map `c.cache`
 	c.mux.Lock()
 
@@ -81,9 +308,10 @@ func (c *Cache) reap(now time.Time, last time.Duration) {
 
 	// Iterating over each key-value pair in the cache
 	for k, v := range c.cache {
-		// Deleting all the cache entries that were created before the specified duration
-		if v.createdAt.Before(now.Add(-last)) {
-			delete(c.cache, k)
+		// Deleting all the cache entries that can no longer be served, even as stale
+		if v.expiresAt.Add(c.limits.StaleFor).Before(now) {
+			c.remove(k)
+			c.stats.Expirations++
 		}
 	}
 }
@@ -2,6 +2,7 @@ package pokecache
 
 import (
 	"fmt"
+	"runtime"
 	"testing"
 	"time"
 )
@@ -25,6 +26,7 @@ func TestAddGet(t *testing.T) {
 	for i, c := range cases {
 		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
 			cache := NewCache(interval)
+			defer cache.Close()
 			cache.Add(c.key, c.val)
 			val, ok := cache.Get(c.key)
 			if !ok {
@@ -43,6 +45,7 @@ func TestReapLoop(t *testing.T) {
 	const baseTime = 5 * time.Millisecond
 	const waitTime = baseTime + 5*time.Millisecond
 	cache := NewCache(baseTime)
+	defer cache.Close()
 	cache.Add("https://example.com", []byte("testdata"))
 
 	_, ok := cache.Get("https://example.com")
@@ -59,3 +62,145 @@ func TestReapLoop(t *testing.T) {
 		return
 	}
 }
+
+func TestLRUEviction(t *testing.T) {
+	cases := []struct {
+		name    string
+		limits  Limits
+		evicted string
+	}{
+		{
+			name:    "max entries",
+			limits:  Limits{MaxEntries: 2},
+			evicted: "b",
+		},
+		{
+			// every entry is 1 byte of key plus 4 bytes of value
+			name:    "max bytes",
+			limits:  Limits{MaxBytes: 12},
+			evicted: "b",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cache := NewBoundedCache(time.Minute, c.limits)
+			defer cache.Close()
+			cache.Add("a", []byte("aaaa"))
+			cache.Add("b", []byte("bbbb"))
+			// reading "a" makes "b" the least recently used entry
+			cache.Get("a")
+			cache.Add("c", []byte("cccc"))
+
+			if _, ok := cache.Get(c.evicted); ok {
+				t.Errorf("expected %s to be evicted", c.evicted)
+			}
+			for _, key := range []string{"a", "c"} {
+				if _, ok := cache.Get(key); !ok {
+					t.Errorf("expected to find %s", key)
+				}
+			}
+			if cache.Len() != 2 || cache.Bytes() != 10 {
+				t.Errorf("expected 2 entries and 10 bytes, got %d and %d", cache.Len(), cache.Bytes())
+			}
+		})
+	}
+}
+
+func TestOversizedEntryNotStored(t *testing.T) {
+	cache := NewBoundedCache(time.Minute, Limits{MaxBytes: 8})
+	defer cache.Close()
+	cache.Add("a", []byte("aaaa"))
+	cache.Add("huge", []byte("far too large"))
+
+	if _, ok := cache.Get("huge"); ok {
+		t.Errorf("expected oversized entry to be dropped")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Errorf("expected to find a")
+	}
+}
+
+func TestCloseStopsReapLoop(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	caches := []*Cache{}
+	for i := 0; i < 10; i++ {
+		caches = append(caches, NewCache(time.Millisecond))
+	}
+	for _, cache := range caches {
+		if err := cache.Close(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	// Closing twice must not block or panic
+	caches[0].Close()
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("expected reap loops to stop, %d goroutines leaked", after-before)
+	}
+}
+
+func TestStats(t *testing.T) {
+	cache := NewBoundedCache(time.Minute, Limits{MaxEntries: 1})
+	defer cache.Close()
+
+	cache.Add("a", []byte("aaaa"))
+	cache.Get("a")
+	cache.Get("b")
+	cache.Add("c", []byte("cc"))
+
+	expected := Stats{Hits: 1, Misses: 1, Evictions: 1, Entries: 1, Bytes: 3}
+	if stats := cache.Stats(); stats != expected {
+		t.Errorf("expected %+v, got %+v", expected, stats)
+	}
+
+	cache.Clear()
+	if stats := cache.Stats(); stats.Entries != 0 || stats.Bytes != 0 {
+		t.Errorf("expected an empty cache, got %+v", stats)
+	}
+}
+
+func TestPerEntryTTL(t *testing.T) {
+	cache := NewCache(time.Minute)
+	defer cache.Close()
+
+	cache.AddWithTTL("short", []byte("testdata"), -time.Second)
+	cache.AddWithTTL("long", []byte("testdata"), time.Hour)
+
+	if _, ok := cache.Get("short"); ok {
+		t.Errorf("expected short to be expired")
+	}
+	if _, ok := cache.Get("long"); !ok {
+		t.Errorf("expected to find long")
+	}
+}
+
+func TestGetStale(t *testing.T) {
+	cache := NewBoundedCache(time.Minute, Limits{StaleFor: time.Hour})
+	defer cache.Close()
+
+	cache.AddWithTTL("expired", []byte("testdata"), -time.Second)
+	cache.AddWithTTL("gone", []byte("testdata"), -2*time.Hour)
+	cache.Add("fresh", []byte("testdata"))
+
+	cases := []struct {
+		key   string
+		stale bool
+		ok    bool
+	}{
+		{key: "expired", stale: true, ok: true},
+		{key: "gone", stale: false, ok: false},
+		{key: "fresh", stale: false, ok: true},
+	}
+	for _, c := range cases {
+		_, stale, ok := cache.GetStale(c.key)
+		if stale != c.stale || ok != c.ok {
+			t.Errorf("GetStale(%s) == stale %v ok %v, expected stale %v ok %v", c.key, stale, ok, c.stale, c.ok)
+		}
+	}
+
+	if _, ok := cache.Get("expired"); ok {
+		t.Errorf("expected Get to never return stale values")
+	}
+}
@@ -0,0 +1,12 @@
+package pokecache
+
+// Stats is a snapshot of the activity and size of a cache.
+type Stats struct {
+	Hits        int64 // Hits is the number of Get calls that found a value
+	Misses      int64 // Misses is the number of Get calls that found nothing
+	StaleHits   int64 // StaleHits is the number of GetStale calls that found an expired value
+	Evictions   int64 // Evictions is the number of entries removed to stay within limits
+	Expirations int64 // Expirations is the number of entries removed because they were too old
+	Entries     int   // Entries is the number of entries currently stored
+	Bytes       int   // Bytes is the total size of the stored keys and values
+}
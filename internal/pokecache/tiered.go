@@ -0,0 +1,180 @@
+package pokecache
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// TieredCache layers the in-memory Cache over a DiskCache. Reads are served
+// from memory when possible and fall back to disk, promoting what they find;
+// writes go to both tiers.
+type TieredCache struct {
+	mem       *Cache       // mem is the fast, short-lived first tier
+	disk      *DiskCache   // disk is the persistent second tier, nil for memory only
+	hits      atomic.Int64 // hits counts Get calls served by either tier
+	misses    atomic.Int64 // misses counts Get calls that no tier could serve
+	staleHits atomic.Int64 // staleHits counts GetStale calls served an expired value from memory
+}
+
+// NewTieredCache creates a TieredCache over mem and disk. disk may be nil,
+// in which case the TieredCache behaves like mem alone.
+func NewTieredCache(mem *Cache, disk *DiskCache) *TieredCache {
+	return &TieredCache{mem: mem, disk: disk}
+}
+
+// Add stores value under key in every tier, using each tier's default TTL.
+func (t *TieredCache) Add(key string, value []byte) {
+	t.mem.Add(key, value)
+	if t.disk != nil {
+		t.disk.Add(key, value)
+	}
+}
+
+// AddWithTTL stores value under key in every tier until ttl has elapsed.
+func (t *TieredCache) AddWithTTL(key string, value []byte, ttl time.Duration) {
+	t.AddEntry(key, Entry{Value: value}, ttl)
+}
+
+// AddEntry stores a value and its HTTP validators under key in every tier
+// until ttl has elapsed.
+func (t *TieredCache) AddEntry(key string, entry Entry, ttl time.Duration) {
+	t.mem.AddEntry(key, entry, ttl)
+	if t.disk != nil {
+		t.disk.AddEntry(key, entry, ttl)
+	}
+}
+
+// Peek returns the entry stored under key with its validators from the
+// fastest tier holding it, even if it has expired.
+func (t *TieredCache) Peek(key string) (Entry, bool) {
+	if entry, ok := t.mem.Peek(key); ok {
+		return entry, true
+	}
+	if t.disk == nil {
+		return Entry{}, false
+	}
+	return t.disk.Peek(key)
+}
+
+// Get fetches the value stored under key from the fastest tier holding it.
+func (t *TieredCache) Get(key string) ([]byte, bool) {
+	val, ok := t.mem.Get(key)
+	if !ok {
+		val, ok = t.getDisk(key)
+	}
+	t.record(ok, false)
+	return val, ok
+}
+
+// GetStale fetches the value stored under key like Get, but may also return
+// an expired value still held in memory, flagged as stale.
+func (t *TieredCache) GetStale(key string) (val []byte, stale bool, ok bool) {
+	val, stale, ok = t.mem.GetStale(key)
+	if !ok || stale {
+		// The disk may hold a fresher copy than the stale one in memory
+		if fresh, found := t.getDisk(key); found {
+			val, stale, ok = fresh, false, true
+		}
+	}
+	t.record(ok, stale)
+	return val, stale, ok
+}
+
+// getDisk fetches the value stored under key from the disk tier and
+// promotes it to memory, with its validators and for the rest of its TTL.
+// It doesn't count as a hit or miss of the TieredCache, the caller records
+// the outcome of the whole lookup.
+func (t *TieredCache) getDisk(key string) ([]byte, bool) {
+	if t.disk == nil {
+		return nil, false
+	}
+	entry, expiresAt, ok := t.disk.entry(key)
+	remaining := time.Until(expiresAt)
+	if !ok || remaining <= 0 {
+		return nil, false
+	}
+	t.mem.AddEntry(key, entry, remaining)
+	return entry.Value, true
+}
+
+// record counts the outcome of one Get or GetStale call.
+func (t *TieredCache) record(ok, stale bool) {
+	switch {
+	case !ok:
+		t.misses.Add(1)
+	case stale:
+		t.staleHits.Add(1)
+	default:
+		t.hits.Add(1)
+	}
+}
+
+// Delete removes the entry stored under key from every tier.
+func (t *TieredCache) Delete(key string) {
+	t.mem.Delete(key)
+	if t.disk != nil {
+		t.disk.Delete(key)
+	}
+}
+
+// Len returns the number of entries in the persistent tier, which holds
+// every entry that was added, or in memory when there is no disk tier.
+func (t *TieredCache) Len() int {
+	if t.disk != nil {
+		return t.disk.Len()
+	}
+	return t.mem.Len()
+}
+
+// Keys returns the keys stored in any tier, in no particular order.
+func (t *TieredCache) Keys() []string {
+	keys := t.mem.Keys()
+	if t.disk == nil {
+		return keys
+	}
+	seen := map[string]bool{}
+	for _, key := range keys {
+		seen[key] = true
+	}
+	for _, key := range t.disk.Keys() {
+		if !seen[key] {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// Clear removes every entry from every tier.
+func (t *TieredCache) Clear() {
+	t.mem.Clear()
+	if t.disk != nil {
+		t.disk.Clear()
+	}
+}
+
+// Stats returns the hits, stale hits and misses of the cache as a whole, the
+// evictions and expirations of all tiers, and the size of the largest tier.
+func (t *TieredCache) Stats() Stats {
+	stats := t.mem.Stats()
+	if t.disk != nil {
+		disk := t.disk.Stats()
+		stats.Evictions += disk.Evictions
+		stats.Expirations += disk.Expirations
+		stats.Entries = disk.Entries
+		stats.Bytes = disk.Bytes
+	}
+	stats.Hits = t.hits.Load()
+	stats.Misses = t.misses.Load()
+	stats.StaleHits = t.staleHits.Load()
+	return stats
+}
+
+// Close closes every tier.
+func (t *TieredCache) Close() error {
+	err := t.mem.Close()
+	if t.disk != nil {
+		err = errors.Join(err, t.disk.Close())
+	}
+	return err
+}
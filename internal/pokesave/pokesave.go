@@ -0,0 +1,165 @@
+// Package pokesave reads and writes the Pokedex save file.
+//
+// Save files are versioned JSON documents. When the format changes,
+// CurrentVersion is bumped and a migration from the previous version is
+// registered in migrations, so saves written by older releases keep loading.
+package pokesave
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+// CurrentVersion is the schema version written by Save.
+const CurrentVersion = 2
+
+// appName is the directory name used under the XDG data directory.
+const appName = "pokedexcli"
+
+// State is the part of a REPL session that is persisted between runs.
+type State struct {
+	CaughtPokemon    map[string]pokeapi.Pokemon `json:"caught_pokemon"`     // Pokemon caught by the user, keyed by name
+	NextLocationsURL *string                    `json:"next_locations_url"` // URL of next page of locations
+	PrevLocationsURL *string                    `json:"prev_locations_url"` // URL of previous page of locations
+	Inventory        map[string]int             `json:"inventory"`          // item counts, keyed by item name
+}
+
+// saveFile is the on-disk layout of a save: a version header and the state.
+type saveFile struct {
+	Version int       `json:"version"`  // schema version of this file
+	SavedAt time.Time `json:"saved_at"` // time the file was written
+	State
+}
+
+// migration upgrades a decoded save document by exactly one version.
+type migration func(doc map[string]json.RawMessage) error
+
+// migrations maps a schema version to the migration that upgrades it to the
+// next version. Every version below CurrentVersion must have an entry here.
+var migrations = map[int]migration{
+	// Version 2 introduced a per-trainer item inventory.
+	1: func(doc map[string]json.RawMessage) error {
+		doc["inventory"] = json.RawMessage(`{}`)
+		return nil
+	},
+}
+
+// DefaultDir returns the application directory under the XDG data directory,
+// falling back to ~/.local/share when XDG_DATA_HOME is not set.
+func DefaultDir() (string, error) {
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dataHome = filepath.Join(home, ".local", "share")
+	}
+	return filepath.Join(dataHome, appName), nil
+}
+
+// Save writes state to path, creating the parent directory if needed.
+// The file is written to a temporary file first and renamed into place,
+// so an interrupted save never leaves a truncated file behind.
+func Save(path string, state State) error {
+	dat, err := json.MarshalIndent(saveFile{
+		Version: CurrentVersion,
+		SavedAt: time.Now().UTC(),
+		State:   state,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(dat); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Load reads the save file at path, migrating it to CurrentVersion if it was
+// written by an older release. A missing file is reported with an error
+// matching os.ErrNotExist.
+func Load(path string) (State, error) {
+	dat, err := os.ReadFile(path)
+	if err != nil {
+		return State{}, err
+	}
+
+	doc := map[string]json.RawMessage{}
+	if err := json.Unmarshal(dat, &doc); err != nil {
+		return State{}, fmt.Errorf("corrupt save file %s: %w", path, err)
+	}
+
+	if err := migrate(doc); err != nil {
+		return State{}, fmt.Errorf("save file %s: %w", path, err)
+	}
+
+	dat, err = json.Marshal(doc)
+	if err != nil {
+		return State{}, err
+	}
+	save := saveFile{}
+	if err := json.Unmarshal(dat, &save); err != nil {
+		return State{}, fmt.Errorf("corrupt save file %s: %w", path, err)
+	}
+	if save.CaughtPokemon == nil {
+		save.CaughtPokemon = map[string]pokeapi.Pokemon{}
+	}
+	if save.Inventory == nil {
+		save.Inventory = map[string]int{}
+	}
+	return save.State, nil
+}
+
+// migrate upgrades doc in place from its recorded version to CurrentVersion.
+func migrate(doc map[string]json.RawMessage) error {
+	rawVersion, ok := doc["version"]
+	if !ok {
+		return errors.New("missing schema version")
+	}
+	version := 0
+	if err := json.Unmarshal(rawVersion, &version); err != nil {
+		return fmt.Errorf("invalid schema version: %w", err)
+	}
+	if version > CurrentVersion {
+		return fmt.Errorf("schema version %d is newer than supported version %d", version, CurrentVersion)
+	}
+
+	for ; version < CurrentVersion; version++ {
+		m, ok := migrations[version]
+		if !ok {
+			return fmt.Errorf("no migration from schema version %d", version)
+		}
+		if err := m(doc); err != nil {
+			return fmt.Errorf("migrating from schema version %d: %w", version, err)
+		}
+	}
+
+	dat, err := json.Marshal(CurrentVersion)
+	if err != nil {
+		return err
+	}
+	doc["version"] = dat
+	return nil
+}
@@ -0,0 +1,89 @@
+package pokesave
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "save.json")
+	next := "https://pokeapi.co/api/v2/location-area?offset=40&limit=20"
+
+	state := State{
+		CaughtPokemon:    map[string]pokeapi.Pokemon{"pikachu": {ID: 25, Name: "pikachu"}},
+		NextLocationsURL: &next,
+	}
+	if err := Save(path, state); err != nil {
+		t.Fatalf("unexpected error saving: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error loading: %v", err)
+	}
+	if loaded.CaughtPokemon["pikachu"].ID != 25 {
+		t.Errorf("expected to find pikachu, got %v", loaded.CaughtPokemon)
+	}
+	if loaded.NextLocationsURL == nil || *loaded.NextLocationsURL != next {
+		t.Errorf("expected next URL %q, got %v", next, loaded.NextLocationsURL)
+	}
+	if loaded.PrevLocationsURL != nil {
+		t.Errorf("expected no previous URL, got %q", *loaded.PrevLocationsURL)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+	}{
+		{name: "missing version", content: `{"caught_pokemon": {}}`},
+		{name: "future version", content: `{"version": 999}`},
+		{name: "not json", content: `not a save file`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "save.json")
+			if err := os.WriteFile(path, []byte(c.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Load(path); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+
+	_, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected os.ErrNotExist, got %v", err)
+	}
+}
+
+func TestLoadMigratesVersion1(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	v1 := `{
+		"version": 1,
+		"caught_pokemon": {"bulbasaur": {"id": 1, "name": "bulbasaur"}},
+		"next_locations_url": null,
+		"prev_locations_url": null
+	}`
+	if err := os.WriteFile(path, []byte(v1), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error loading: %v", err)
+	}
+	if state.CaughtPokemon["bulbasaur"].ID != 1 {
+		t.Errorf("expected to find bulbasaur, got %v", state.CaughtPokemon)
+	}
+	if state.Inventory == nil {
+		t.Errorf("expected an empty inventory after migration")
+	}
+}
@@ -0,0 +1,109 @@
+package pokesave
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DefaultProfile is the profile used when no other profile is selected.
+const DefaultProfile = "default"
+
+// validProfileName restricts profile names to characters that are safe in file names.
+var validProfileName = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// Store manages the save files of several named profiles (trainers).
+// Each profile is stored in its own file in the profiles directory.
+type Store struct {
+	dir string // directory holding one <profile>.json file per profile
+}
+
+// OpenStore returns a Store rooted at dir, creating the directory if needed.
+// A single-trainer save.json written by older releases is adopted as the
+// default profile.
+func OpenStore(dir string) (*Store, error) {
+	s := &Store{dir: filepath.Join(dir, "profiles")}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	legacy := filepath.Join(dir, "save.json")
+	if _, err := os.Stat(legacy); err == nil && !s.Exists(DefaultProfile) {
+		if err := os.Rename(legacy, s.Path(DefaultProfile)); err != nil {
+			return nil, fmt.Errorf("adopting %s as the %s profile: %w", legacy, DefaultProfile, err)
+		}
+	}
+	return s, nil
+}
+
+// ValidateProfileName returns an error if name can't be used as a profile name.
+func ValidateProfileName(name string) error {
+	if !validProfileName.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use up to 32 lowercase letters, digits, '-' or '_'", name)
+	}
+	return nil
+}
+
+// Path returns the save file path of the given profile.
+func (s *Store) Path(profile string) string {
+	return filepath.Join(s.dir, profile+".json")
+}
+
+// Exists reports whether a save file exists for the given profile.
+func (s *Store) Exists(profile string) bool {
+	_, err := os.Stat(s.Path(profile))
+	return err == nil
+}
+
+// Save writes the state of the given profile.
+func (s *Store) Save(profile string, state State) error {
+	if err := ValidateProfileName(profile); err != nil {
+		return err
+	}
+	return Save(s.Path(profile), state)
+}
+
+// Load reads the state of the given profile. A profile that has never been
+// saved is reported with an error matching os.ErrNotExist.
+func (s *Store) Load(profile string) (State, error) {
+	if err := ValidateProfileName(profile); err != nil {
+		return State{}, err
+	}
+	return Load(s.Path(profile))
+}
+
+// Delete removes the save file of the given profile.
+func (s *Store) Delete(profile string) error {
+	if err := ValidateProfileName(profile); err != nil {
+		return err
+	}
+	err := os.Remove(s.Path(profile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("no profile named %s", profile)
+	}
+	return err
+}
+
+// List returns the names of all saved profiles in alphabetical order.
+func (s *Store) List() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := []string{}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok || entry.IsDir() || ValidateProfileName(name) != nil {
+			continue
+		}
+		profiles = append(profiles, name)
+	}
+	sort.Strings(profiles)
+	return profiles, nil
+}
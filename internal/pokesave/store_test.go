@@ -0,0 +1,66 @@
+package pokesave
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
+)
+
+func TestStoreProfiles(t *testing.T) {
+	store, err := OpenStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error opening store: %v", err)
+	}
+
+	for _, name := range []string{"misty", "brock"} {
+		state := State{CaughtPokemon: map[string]pokeapi.Pokemon{name: {Name: name}}}
+		if err := store.Save(name, state); err != nil {
+			t.Fatalf("unexpected error saving %s: %v", name, err)
+		}
+	}
+
+	profiles, err := store.List()
+	if err != nil {
+		t.Fatalf("unexpected error listing: %v", err)
+	}
+	if !reflect.DeepEqual(profiles, []string{"brock", "misty"}) {
+		t.Errorf("expected [brock misty], got %v", profiles)
+	}
+
+	state, err := store.Load("misty")
+	if err != nil {
+		t.Fatalf("unexpected error loading: %v", err)
+	}
+	if _, ok := state.CaughtPokemon["brock"]; ok {
+		t.Errorf("expected profiles to be independent, got %v", state.CaughtPokemon)
+	}
+
+	if err := store.Delete("brock"); err != nil {
+		t.Fatalf("unexpected error deleting: %v", err)
+	}
+	if _, err := store.Load("brock"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected os.ErrNotExist, got %v", err)
+	}
+	if err := store.Save("../escape", State{}); err == nil {
+		t.Errorf("expected an invalid profile name error")
+	}
+}
+
+func TestOpenStoreAdoptsLegacySave(t *testing.T) {
+	dir := t.TempDir()
+	if err := Save(filepath.Join(dir, "save.json"), State{}); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := OpenStore(dir)
+	if err != nil {
+		t.Fatalf("unexpected error opening store: %v", err)
+	}
+	if !store.Exists(DefaultProfile) {
+		t.Errorf("expected the legacy save to become the %s profile", DefaultProfile)
+	}
+}
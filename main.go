@@ -1,21 +1,151 @@
 package main
 
 import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/masteidel/pokedexcli/internal/pokeapi"
+	"github.com/masteidel/pokedexcli/internal/pokecache"
+	"github.com/masteidel/pokedexcli/internal/pokesave"
 )
 
+const (
+	// cacheInterval is how often expired responses are reaped from memory
+	cacheInterval = 5 * time.Minute
+
+	// cacheMaxEntries and cacheMaxBytes bound the in-memory cache; Pokemon
+	// responses can be hundreds of kilobytes because of their sprite metadata
+	cacheMaxEntries = 500
+	cacheMaxBytes   = 64 << 20
+
+	// cacheStaleFor is how long expired responses are still served from memory
+	// while they are refreshed in the background
+	cacheStaleFor = time.Hour
+
+	// diskCacheMaxAge is how long responses are kept in the persistent cache.
+	// PokeAPI data changes very rarely, so it can be much longer than cacheInterval.
+	diskCacheMaxAge = 7 * 24 * time.Hour
+)
+
+// apiURLEnv is the environment variable that sets the default of --api-url
+const apiURLEnv = "POKEDEX_API_URL"
+
 func main() {
-	// Initializing a new client for the PokeAPI with a 5-second timeout
-	pokeClient := pokeapi.NewClient(5*time.Second, time.Minute*5)
+	// Parsing command line flags
+	profile := flag.String("profile", pokesave.DefaultProfile, "name of the trainer profile to play as")
+	apiURL := flag.String("api-url", envOr(apiURLEnv, pokeapi.DefaultBaseURL), "base URL of the PokeAPI, e.g. a local mirror (env "+apiURLEnv+")")
+	offline := flag.Bool("offline", false, "serve PokeAPI data only from the bundle downloaded by the sync command")
+	bundlePath := flag.String("bundle", defaultBundlePath(), "path of the offline bundle")
+	language := flag.String("lang", "en", "language of Pokedex entries, e.g. en, de, fr or ja")
+	prefetchAreas := flag.Bool("prefetch-areas", false, "download every listed location area in the background, so explore is instant")
+	flag.Parse()
+
+	if err := pokesave.ValidateProfileName(*profile); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	// Initializing a new client for the PokeAPI with a 5-second timeout,
+	// keeping responses on disk so they survive restarts
+	opts := []pokeapi.Option{
+		pokeapi.WithBaseURL(*apiURL),
+		pokeapi.WithTimeout(5 * time.Second),
+		pokeapi.WithPrefetch(pokeapi.PrefetchPolicy{NextPage: true, Locations: *prefetchAreas}),
+	}
+	if *offline {
+		// Offline, the bundle holds every response, so nothing needs caching
+		bundle, err := pokeapi.OpenBundle(*bundlePath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "offline mode unavailable, run sync while online first:", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Offline: using %d responses synced on %s\n", bundle.Len(), bundle.Manifest.SyncedAt.Local().Format("2006-01-02"))
+		opts = append(opts, pokeapi.WithBundle(bundle), pokeapi.WithCache(nil))
+	} else {
+		opts = append(opts, pokeapi.WithCache(newCache()))
+	}
+	pokeClient := pokeapi.NewClient(opts...)
+
+	// Opening the profile store; without one the session simply isn't persisted
+	saves, err := openSaveStore()
+	if err != nil {
+		fmt.Println("saving disabled:", err)
+	}
 
 	// Setting up configuration where pokeapiClient is the initialized client
 	cfg := &config{
 		caughtPokemon: map[string]pokeapi.Pokemon{},
+		inventory:     map[string]int{},
 		pokeapiClient: pokeClient,
+		saves:         saves,
+		profile:       *profile,
+		bundlePath:    *bundlePath,
+		language:      *language,
+	}
+	cfg.locations = cfg.pokeapiClient.LocationAreaPager(pokeapi.DefaultPageSize)
+
+	// Restoring the previous session of the profile, if there is one
+	if cfg.saves != nil {
+		if err := loadSession(cfg); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			fmt.Println("could not load save:", err)
+		}
 	}
 
 	// Starting the REPL (Read-Eval-Print Loop) with the given configuration
 	startRepl(cfg)
 }
+
+// envOr returns the value of the environment variable key, or fallback if it is unset or empty.
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// newCache builds the two-tier response cache: a short-lived in-memory cache
+// over a persistent disk cache in the user's cache directory. If the disk
+// cache can't be opened, responses are only cached in memory.
+func newCache() *pokecache.TieredCache {
+	mem := pokecache.NewBoundedCache(cacheInterval, pokecache.Limits{
+		MaxEntries: cacheMaxEntries,
+		MaxBytes:   cacheMaxBytes,
+		StaleFor:   cacheStaleFor,
+	})
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		fmt.Println("persistent cache disabled:", err)
+		return pokecache.NewTieredCache(mem, nil)
+	}
+	disk, err := pokecache.NewDiskCache(filepath.Join(dir, "pokedexcli", "http"), diskCacheMaxAge)
+	if err != nil {
+		fmt.Println("persistent cache disabled:", err)
+		return pokecache.NewTieredCache(mem, nil)
+	}
+	return pokecache.NewTieredCache(mem, disk)
+}
+
+// defaultBundlePath returns where the sync command writes the offline bundle
+// by default, next to the save files, or "" if there is no data directory.
+func defaultBundlePath() string {
+	dir, err := pokesave.DefaultDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "bundle.zip")
+}
+
+// openSaveStore opens the profile store in the default data directory.
+func openSaveStore() (*pokesave.Store, error) {
+	dir, err := pokesave.DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+	return pokesave.OpenStore(dir)
+}
@@ -2,32 +2,65 @@ package main
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
 	"strings"
+	"sync"
 
 	"github.com/masteidel/pokedexcli/internal/pokeapi"
+	"github.com/masteidel/pokedexcli/internal/pokesave"
 )
 
 type config struct {
-	pokeapiClient    pokeapi.Client // Client for Pokeapi
-	nextLocationsURL *string        // URL of next page of locations
-	prevLocationsURL *string        // URL of previous page of locations
-	caughtPokemon    map[string]pokeapi.Pokemon
+	pokeapiClient pokeapi.Client                           // Client for Pokeapi
+	locations     *pokeapi.Pager[pokeapi.NamedAPIResource] // Pages through the locations shown by map and mapb
+	caughtPokemon map[string]pokeapi.Pokemon
+	inventory     map[string]int  // Item counts of the active profile, keyed by item name
+	saves         *pokesave.Store // Profile save files, nil disables saving
+	profile       string          // Name of the active profile
+	bundlePath    string          // Path of the offline bundle written by sync, empty disables sync
+	language      string          // Language of flavor texts and names, e.g. "en"
 }
 
 // Function to start the REPL
 func startRepl(cfg *config) {
-	// create a new scanner for reading from the standard input
-	reader := bufio.NewScanner(os.Stdin)
+	// route Ctrl-C to the running command instead of killing the program
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
 
-	// infinite for loop to keep the REPL running until forced exit
+	runRepl(cfg, getCommands(), os.Stdin, os.Stdout, interrupts)
+
+	// the input ended (Ctrl-D), leave the same way the exit command does
+	commandExit(context.Background(), cfg)
+}
+
+// runRepl reads commands from input and runs them until input ends. The
+// prompt and command errors are written to output. Every value received on
+// interrupts cancels the running command, or reminds the user how to quit
+// at the prompt.
+func runRepl(cfg *config, commands map[string]cliCommand, input io.Reader, output io.Writer, interrupts <-chan os.Signal) {
+	// create a new scanner for reading from the input
+	reader := bufio.NewScanner(input)
+
+	canceller := &commandCanceller{output: output}
+	go canceller.listen(interrupts)
+
+	// for loop to keep the REPL running until the input ends
 	for {
 		// prompt for the user
-		fmt.Print("Pokedex > ")
+		prompt := fmt.Sprintf("Pokedex (%s) > ", cfg.profile)
+		canceller.waitAt(prompt)
+		fmt.Fprint(output, prompt)
 
-		// scan the next line from the standard input
-		reader.Scan()
+		// scan the next line from the input, stopping when there is none
+		if !reader.Scan() {
+			return
+		}
 
 		// clean the input and split it into words
 		words := cleanInput(reader.Text())
@@ -49,23 +82,90 @@ func startRepl(cfg *config) {
 		}
 
 		// get the corresponding command struct if exists
-		command, exists := getCommands()[commandName]
+		command, exists := commands[commandName]
 		if exists {
-			// execute the command callback and check for errors
-			err := command.callback(cfg, args...)
+			// execute the command callback with a context cancelled by Ctrl-C and check for errors
+			ctx := canceller.start()
+			err := command.callback(ctx, cfg, args...)
+			canceller.finish()
 			if err != nil {
 				// if there is an error, print it and continue with the next iteration
-				fmt.Println(err)
+				fmt.Fprintln(output, describeError(err))
 			}
 			continue
 		} else {
 			// if command does not exist, print an error message and continue with the next iteration
-			fmt.Println("Unknown command")
+			fmt.Fprintln(output, "Unknown command")
 			continue
 		}
 	}
 }
 
+// commandCanceller cancels the running command when the user presses Ctrl-C.
+// While no command is running, Ctrl-C just reminds the user how to quit.
+type commandCanceller struct {
+	mux    sync.Mutex         // mux guards the fields below against the signal listener
+	cancel context.CancelFunc // cancel stops the running command, nil at the prompt
+	prompt string             // prompt is reprinted when Ctrl-C is pressed at the prompt
+	output io.Writer          // output is where the REPL prints the prompt
+}
+
+// listen handles interrupt signals until the channel is closed.
+func (cc *commandCanceller) listen(interrupts <-chan os.Signal) {
+	for range interrupts {
+		cc.mux.Lock()
+		if cc.cancel != nil {
+			cc.cancel()
+		} else {
+			fmt.Fprint(cc.output, "\nUse the exit command to quit\n"+cc.prompt)
+		}
+		cc.mux.Unlock()
+	}
+}
+
+// waitAt records that the REPL is waiting for input at prompt.
+func (cc *commandCanceller) waitAt(prompt string) {
+	cc.mux.Lock()
+	defer cc.mux.Unlock()
+	cc.prompt = prompt
+}
+
+// start returns the context for a new command, cancelled by the next Ctrl-C.
+func (cc *commandCanceller) start() context.Context {
+	cc.mux.Lock()
+	defer cc.mux.Unlock()
+	ctx, cancel := context.WithCancel(context.Background())
+	cc.cancel = cancel
+	return ctx
+}
+
+// finish releases the context of the command that just returned.
+func (cc *commandCanceller) finish() {
+	cc.mux.Lock()
+	defer cc.mux.Unlock()
+	cc.cancel()
+	cc.cancel = nil
+}
+
+// Function to turn errors returned by commands into friendly messages
+func describeError(err error) string {
+	var httpErr *pokeapi.HTTPError
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "Command cancelled"
+	case errors.Is(err, pokeapi.ErrRateLimited):
+		return "PokeAPI is receiving too many requests, try again in a moment"
+	case errors.Is(err, pokeapi.ErrServer) && errors.As(err, &httpErr):
+		return fmt.Sprintf("PokeAPI is having trouble (status %d), try again later", httpErr.StatusCode)
+	case errors.Is(err, pokeapi.ErrOffline):
+		return err.Error() + ", run sync while online to download it"
+	case errors.Is(err, pokeapi.ErrNotFound):
+		return "PokeAPI has no such resource"
+	default:
+		return err.Error()
+	}
+}
+
 // Function to clean the input, convert it to lower case and split it into words
 func cleanInput(text string) []string {
 	output := strings.ToLower(text) // convert the input to lower case
@@ -73,11 +173,29 @@ func cleanInput(text string) []string {
 	return words                    // return the slice of words
 }
 
+// parseFlags stores the value following each "--flag" in args into the
+// matching entry of flags, e.g. {"--type": &typeName}. An argument that is
+// not one of the flags makes it return usage.
+func parseFlags(args []string, flags map[string]*string, usage error) error {
+	for i := 0; i < len(args); i++ {
+		value, ok := flags[args[i]]
+		if !ok {
+			return usage
+		}
+		if i+1 >= len(args) {
+			return fmt.Errorf("%s requires a value", args[i])
+		}
+		*value = args[i+1]
+		i++
+	}
+	return nil
+}
+
 // struct to hold the details of a CLI command
 type cliCommand struct {
-	name        string                         // name of the command
-	description string                         // description of the command
-	callback    func(*config, ...string) error // callback function to be executed when the command is called
+	name        string                                          // name of the command
+	description string                                          // description of the command
+	callback    func(context.Context, *config, ...string) error // callback function to be executed when the command is called, ctx is cancelled by Ctrl-C
 }
 
 // Function to get a map of available commands
@@ -89,8 +207,8 @@ func getCommands() map[string]cliCommand {
 			callback:    commandHelp,
 		},
 		"map": { // Map command details
-			name:        "map",
-			description: "Get the next page of locations",
+			name:        "map [page]",
+			description: "Get the next page of locations, or a specific page",
 			callback:    commandMapf,
 		},
 		"mapb": { // Mapb command details
@@ -108,6 +226,76 @@ func getCommands() map[string]cliCommand {
 			description: "Catch a pokemon",
 			callback:    commandCatch,
 		},
+		"inspect": { // Inspect command details
+			name:        "inspect <pokemon_name>",
+			description: "View details about a caught pokemon",
+			callback:    commandInspect,
+		},
+		"species": { // Species command details
+			name:        "species <name>",
+			description: "Read the Pokedex entry and forms of a species",
+			callback:    commandSpecies,
+		},
+		"evolution": { // Evolution command details
+			name:        "evolution <pokemon_name>",
+			description: "Show the evolution family of a pokemon",
+			callback:    commandEvolution,
+		},
+		"move": { // Move command details
+			name:        "move <move_name>",
+			description: "Show the battle data of a move",
+			callback:    commandMove,
+		},
+		"moves": { // Moves command details
+			name:        "moves <pokemon_name> [--type <type_name>] [--class physical|special|status]",
+			description: "List the moves of a caught pokemon",
+			callback:    commandMoves,
+		},
+		"weakness": { // Weakness command details
+			name:        "weakness <pokemon_name>",
+			description: "List the types a pokemon is weak or resistant to",
+			callback:    commandWeakness,
+		},
+		"matchup": { // Matchup command details
+			name:        "matchup <attacking_type> <pokemon_name>",
+			description: "Show how effective a type is against a pokemon",
+			callback:    commandMatchup,
+		},
+		"ability": { // Ability command details
+			name:        "ability <ability_name>",
+			description: "Describe an ability and list your pokemon that have it",
+			callback:    commandAbility,
+		},
+		"pokedex": { // Pokedex command details
+			name:        "pokedex [--type <type_name>] [--sort <stat_name>]",
+			description: "List all caught pokemon",
+			callback:    commandPokedex,
+		},
+		"save": { // Save command details
+			name:        "save",
+			description: "Save your pokedex and map position",
+			callback:    commandSave,
+		},
+		"load": { // Load command details
+			name:        "load",
+			description: "Restore your last saved pokedex and map position",
+			callback:    commandLoad,
+		},
+		"profile": { // Profile command details
+			name:        "profile new|switch|list|delete [profile_name]",
+			description: "Manage trainer profiles",
+			callback:    commandProfile,
+		},
+		"cache": { // Cache command details
+			name:        "cache stats|list|clear|evict <key>",
+			description: "Inspect and manage cached API responses",
+			callback:    commandCache,
+		},
+		"sync": { // Sync command details
+			name:        "sync",
+			description: "Download PokeAPI data for use with --offline",
+			callback:    commandSync,
+		},
 		"exit": { // Exit command details
 			name:        "exit",
 			description: "Exit the Pokedex",
@@ -1,7 +1,18 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/masteidel/pokedexcli/internal/pokeapi"
 )
 
 // TestCleanInput is a test function that verifies the behavior of the cleanInput function.
@@ -54,3 +65,132 @@ func TestCleanInput(t *testing.T) {
 		}
 	}
 }
+
+// TestDescribeError verifies that PokeAPI errors are turned into friendly messages.
+func TestDescribeError(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected string
+	}{
+		{
+			err:      &pokeapi.HTTPError{StatusCode: 429, URL: "https://pokeapi.co/api/v2/pokemon/pikachu"},
+			expected: "PokeAPI is receiving too many requests, try again in a moment",
+		},
+		{
+			err:      fmt.Errorf("wrapped: %w", &pokeapi.HTTPError{StatusCode: 503, URL: "https://pokeapi.co/api/v2/pokemon/pikachu"}),
+			expected: "PokeAPI is having trouble (status 503), try again later",
+		},
+		{
+			err:      &pokeapi.OfflineError{Key: "pokemon/pikachu"},
+			expected: "pokemon/pikachu is not in the offline bundle, run sync while online to download it",
+		},
+		{
+			err:      errors.New("you must provide a pokemon name"),
+			expected: "you must provide a pokemon name",
+		},
+	}
+
+	for _, c := range cases {
+		if actual := describeError(c.err); actual != c.expected {
+			t.Errorf("describeError(%v) == %q, expected %q", c.err, actual, c.expected)
+		}
+	}
+}
+
+// TestParseFlags verifies the shared --flag value parsing.
+func TestParseFlags(t *testing.T) {
+	usage := errors.New("usage")
+	var typeName, sortStat string
+	flags := map[string]*string{"--type": &typeName, "--sort": &sortStat}
+
+	if err := parseFlags([]string{"--sort", "speed", "--type", "fire"}, flags, usage); err != nil || typeName != "fire" || sortStat != "speed" {
+		t.Errorf("unexpected result: %q, %q, %v", typeName, sortStat, err)
+	}
+	if err := parseFlags([]string{"--type"}, flags, usage); err == nil || err == usage {
+		t.Errorf("expected a missing value error, got %v", err)
+	}
+	if err := parseFlags([]string{"fire"}, flags, usage); err != usage {
+		t.Errorf("expected the usage error, got %v", err)
+	}
+}
+
+// syncBuffer is a bytes.Buffer safe for the concurrent writes of the REPL
+// and its interrupt listener.
+type syncBuffer struct {
+	mux sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	return b.buf.String()
+}
+
+// TestReplInterrupt verifies that Ctrl-C cancels the running command and
+// returns to the prompt, and only reminds the user how to quit at the prompt.
+func TestReplInterrupt(t *testing.T) {
+	started := make(chan struct{})
+	pinged := make(chan struct{})
+	commands := map[string]cliCommand{
+		"wait": {callback: func(ctx context.Context, cfg *config, args ...string) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+		"ping": {callback: func(ctx context.Context, cfg *config, args ...string) error {
+			close(pinged)
+			return nil
+		}},
+	}
+
+	input, typed := io.Pipe()
+	output := &syncBuffer{}
+	interrupts := make(chan os.Signal)
+	defer close(interrupts)
+	done := make(chan struct{})
+	go func() {
+		runRepl(&config{profile: "ash"}, commands, input, output, interrupts)
+		close(done)
+	}()
+
+	// waitFor fails the test unless the output contains text within a second
+	waitFor := func(text string) {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for !strings.Contains(output.String(), text) {
+			if time.Now().After(deadline) {
+				t.Fatalf("expected %q in the output, got %q", text, output.String())
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	io.WriteString(typed, "wait\n")
+	<-started
+	interrupts <- os.Interrupt
+	waitFor("Command cancelled\nPokedex (ash) > ")
+
+	// At the prompt, Ctrl-C doesn't stop the REPL
+	interrupts <- os.Interrupt
+	waitFor("Use the exit command to quit")
+	io.WriteString(typed, "ping\n")
+	select {
+	case <-pinged:
+	case <-time.After(time.Second):
+		t.Fatal("expected the REPL to keep running commands after Ctrl-C")
+	}
+
+	typed.Close()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the REPL to return when the input ends")
+	}
+}